@@ -2,16 +2,28 @@ package main
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	pb_auth "github.com/yuramishin/expense-tracker/proto/pb_auth"
 	pb_ledger "github.com/yuramishin/expense-tracker/proto/pb_ledger"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
+// maxAttachmentSize matches the ledger service limit for a single attachment.
+const maxAttachmentSize = 10 << 20
+
 var authClient pb_auth.AuthServiceClient
 var ledgerClient pb_ledger.LedgerServiceClient
 
@@ -22,7 +34,9 @@ func main() {
 	}
 	authClient = pb_auth.NewAuthServiceClient(connAuth)
 
-	connLedger, err := grpc.Dial("ledger-service:50052", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	connLedger, err := grpc.Dial("ledger-service:50052",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxAttachmentSize+1<<20)))
 	if err != nil {
 		log.Fatalf("Did not connect to Ledger Service: %v", err)
 	}
@@ -32,9 +46,60 @@ func main() {
 	http.HandleFunc("/login", loginHandler)
 	http.HandleFunc("/logout", logoutHandler)
 	http.HandleFunc("/transaction", transactionHandler)
+	http.HandleFunc("/transaction/receipt", receiptHandler)
+	http.HandleFunc("/transaction/quick", quickAddHandler)
+	http.HandleFunc("/transactions/batch", batchTransactionsHandler)
+	http.HandleFunc("/search", searchHandler)
 	http.HandleFunc("/report", reportHandler)
+	http.HandleFunc("/report/compare", compareReportHandler)
+	http.HandleFunc("/report/pivot", pivotReportHandler)
+	http.HandleFunc("/report/stats", statisticsHandler)
 	http.HandleFunc("/set_budget", setBudgetHandler)
 	http.HandleFunc("/get_budgets", getBudgetsHandler)
+	http.HandleFunc("/budget/history", budgetHistoryHandler)
+	http.HandleFunc("/budget/limits", budgetLimitsHandler)
+	http.HandleFunc("/budget/templates", budgetTemplatesHandler)
+	http.HandleFunc("/budget/templates/save", saveBudgetTemplateHandler)
+	http.HandleFunc("/budget/apply", applyBudgetsHandler)
+	http.HandleFunc("/categories/groups", categoryGroupsHandler)
+	http.HandleFunc("/categories/groups/set", setCategoryGroupHandler)
+	http.HandleFunc("/forecast", forecastHandler)
+	http.HandleFunc("/anomalies", anomaliesHandler)
+	http.HandleFunc("/anomalies/review", reviewAnomalyHandler)
+	http.HandleFunc("/settings", settingsHandler)
+	http.HandleFunc("/settings/timezone", setTimezoneHandler)
+	http.HandleFunc("/settings/budget_mode", setBudgetModeHandler)
+	http.HandleFunc("/settings/refund_attribution", setRefundAttributionHandler)
+	http.HandleFunc("/transaction/update", updateTransactionHandler)
+	http.HandleFunc("/transaction/refund", refundHandler)
+	http.HandleFunc("/transactions", transactionsHandler)
+	http.HandleFunc("/transaction/delete", deleteTransactionHandler)
+	http.HandleFunc("/sync/push", syncPushHandler)
+	http.HandleFunc("/sync/changes", syncChangesHandler)
+	http.HandleFunc("/goals", goalsHandler)
+	http.HandleFunc("/goals/create", createGoalHandler)
+	http.HandleFunc("/goals/contribute", contributeHandler)
+	http.HandleFunc("/attachments", attachmentsHandler)
+	http.HandleFunc("/attachments/upload", uploadAttachmentHandler)
+	http.HandleFunc("/attachments/download", downloadAttachmentHandler)
+	http.HandleFunc("/merchants", merchantsHandler)
+	http.HandleFunc("/merchants/create", createMerchantHandler)
+	http.HandleFunc("/merchants/alias", merchantAliasHandler)
+	http.HandleFunc("/report/merchants", topMerchantsHandler)
+	http.HandleFunc("/subscriptions", subscriptionsHandler)
+	http.HandleFunc("/debts", debtsHandler)
+	http.HandleFunc("/debts/create", createDebtHandler)
+	http.HandleFunc("/debts/payment", debtPaymentHandler)
+	http.HandleFunc("/debts/schedule", debtScheduleHandler)
+	http.HandleFunc("/income", incomeHandler)
+	http.HandleFunc("/envelopes", envelopesHandler)
+	http.HandleFunc("/envelopes/move", moveEnvelopeHandler)
+	http.HandleFunc("/expense_reports", expenseReportsHandler)
+	http.HandleFunc("/expense_reports/create", createExpenseReportHandler)
+	http.HandleFunc("/expense_reports/status", expenseReportStatusHandler)
+	http.HandleFunc("/expense_reports/remove_item", removeExpenseReportItemHandler)
+	http.HandleFunc("/expense_reports/reimburse", reimburseExpenseReportHandler)
+	http.HandleFunc("/events", eventsHandler)
 
 	log.Println("Gateway running on :8080")
 	if err := http.ListenAndServe(":8080", nil); err != nil {
@@ -104,6 +169,9 @@ func transactionHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	req.UserId = valResp.UserId
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = r.Header.Get("Idempotency-Key")
+	}
 
 	resp, err := ledgerClient.CreateTransaction(context.Background(), &req)
 	if err != nil {
@@ -115,6 +183,158 @@ func transactionHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+type batchRow struct {
+	Row          int32    `json:"row"`
+	Amount       float64  `json:"amount"`
+	Category     string   `json:"category"`
+	Description  string   `json:"description"`
+	OccurredAt   string   `json:"occurred_at"`
+	Tags         []string `json:"tags"`
+	Reimbursable bool     `json:"reimbursable"`
+	RowUUID      string   `json:"row_uuid"`
+}
+
+type batchRequest struct {
+	Atomic bool       `json:"atomic"`
+	Rows   []batchRow `json:"rows"`
+}
+
+func receiptHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.ReceiptRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.CreateFromReceipt(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func quickAddHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.QuickAddRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+	if req.IdempotencyKey == "" {
+		req.IdempotencyKey = r.Header.Get("Idempotency-Key")
+	}
+
+	resp, err := ledgerClient.QuickAdd(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func batchTransactionsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req batchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stream, err := ledgerClient.CreateTransactions(context.Background())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	for _, row := range req.Rows {
+		err := stream.Send(&pb_ledger.BatchTransactionItem{
+			Row:     row.Row,
+			Atomic:  req.Atomic,
+			RowUuid: row.RowUUID,
+			Transaction: &pb_ledger.TransactionRequest{
+				UserId:       valResp.UserId,
+				Amount:       row.Amount,
+				Category:     row.Category,
+				Description:  row.Description,
+				OccurredAt:   row.OccurredAt,
+				Tags:         row.Tags,
+				Reimbursable: row.Reimbursable,
+			},
+		})
+		if err != nil {
+			break
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if status.Code(err) == codes.InvalidArgument {
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func searchHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	offset, _ := strconv.Atoi(q.Get("offset"))
+
+	resp, err := ledgerClient.Search(context.Background(), &pb_ledger.SearchRequest{
+		UserId:   valResp.UserId,
+		Query:    q.Get("q"),
+		Category: q.Get("category"),
+		From:     q.Get("from"),
+		To:       q.Get("to"),
+		Limit:    int32(limit),
+		Offset:   int32(offset),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 func reportHandler(w http.ResponseWriter, r *http.Request) {
 	token := r.Header.Get("Authorization")
 	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
@@ -133,6 +353,108 @@ func reportHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+func compareReportHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.CompareReport(context.Background(), &pb_ledger.CompareRequest{
+		UserId: valResp.UserId,
+		Month:  r.URL.Query().Get("month"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func pivotReportHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.GetPivotReport(context.Background(), &pb_ledger.PivotRequest{
+		UserId:    valResp.UserId,
+		FromMonth: r.URL.Query().Get("from"),
+		ToMonth:   r.URL.Query().Get("to"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if r.URL.Query().Get("format") == "csv" {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		writePivotCSV(w, resp)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func writePivotCSV(w io.Writer, p *pb_ledger.PivotReport) {
+	cw := csv.NewWriter(w)
+	cw.Write(append(append([]string{"category"}, p.Periods...), "total"))
+	for _, row := range p.Rows {
+		cw.Write(append(append([]string{csvText(row.Category)}, formatAmounts(row.Values)...), formatAmount(row.Total)))
+	}
+	cw.Write(append(append([]string{"total"}, formatAmounts(p.ColumnTotals)...), formatAmount(p.GrandTotal)))
+	cw.Flush()
+}
+
+// csvText keeps user text from being run as a formula when the CSV is opened
+// in a spreadsheet.
+func csvText(v string) string {
+	if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+		return "'" + v
+	}
+	return v
+}
+
+func formatAmounts(values []float64) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = formatAmount(v)
+	}
+	return out
+}
+
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func statisticsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.GetStatistics(context.Background(), &pb_ledger.StatisticsRequest{
+		UserId: valResp.UserId,
+		From:   r.URL.Query().Get("from"),
+		To:     r.URL.Query().Get("to"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 func setBudgetHandler(w http.ResponseWriter, r *http.Request) {
 	token := r.Header.Get("Authorization")
 	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
@@ -158,6 +480,124 @@ func setBudgetHandler(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(resp)
 }
 
+func budgetHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	category := r.URL.Query().Get("category")
+	if category == "" {
+		http.Error(w, "category is required", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := ledgerClient.GetBudgetHistory(context.Background(), &pb_ledger.BudgetHistoryRequest{
+		UserId:   valResp.UserId,
+		Category: category,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func budgetLimitsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListBudgetLimits(context.Background(), &pb_ledger.BudgetLimitsRequest{
+		UserId:   valResp.UserId,
+		Category: r.URL.Query().Get("category"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func budgetTemplatesHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListBudgetTemplates(context.Background(), &pb_ledger.ListBudgetTemplatesRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func saveBudgetTemplateHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.BudgetTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.SaveBudgetTemplate(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// applyBudgetsHandler applies a template or the previous month's budgets;
+// with "preview": true it only reports the changes.
+func applyBudgetsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.ApplyBudgetsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.ApplyBudgets(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
 func getBudgetsHandler(w http.ResponseWriter, r *http.Request) {
 	token := r.Header.Get("Authorization")
 	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
@@ -175,3 +615,1014 @@ func getBudgetsHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(resp)
 }
+
+func forecastHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.GetForecast(context.Background(), &pb_ledger.ForecastRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func anomaliesHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListAnomalies(context.Background(), &pb_ledger.ListAnomaliesRequest{
+		UserId:          valResp.UserId,
+		IncludeReviewed: r.URL.Query().Get("all") == "true",
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func reviewAnomalyHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.ReviewAnomalyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.ReviewAnomaly(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func settingsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.GetSettings(context.Background(), &pb_ledger.GetSettingsRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func setTimezoneHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.SetTimezoneRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.SetTimezone(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func setBudgetModeHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.SetBudgetModeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.SetBudgetMode(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func updateTransactionHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.UpdateTransactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.UpdateTransaction(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func deleteTransactionHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.DeleteTransactionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.DeleteTransaction(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func syncPushHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.SyncPushRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.SyncPush(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func syncChangesHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.GetChanges(context.Background(), &pb_ledger.ChangesRequest{
+		UserId:     valResp.UserId,
+		SinceToken: r.URL.Query().Get("since"),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func goalsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.GetGoals(context.Background(), &pb_ledger.GetGoalsRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func createGoalHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.CreateGoalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.CreateGoal(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func contributeHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.ContributionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.Contribute(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func attachmentsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	transactionID, err := strconv.ParseInt(r.URL.Query().Get("transaction_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid transaction_id", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := ledgerClient.ListAttachments(context.Background(), &pb_ledger.ListAttachmentsRequest{
+		UserId:        valResp.UserId,
+		TransactionId: transactionID,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// uploadAttachmentHandler accepts a multipart form with a transaction_id field
+// and the receipt in a file field.
+func uploadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentSize+1<<20)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	transactionID, err := strconv.ParseInt(r.FormValue("transaction_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid transaction_id", http.StatusBadRequest)
+		return
+	}
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	if header.Size > maxAttachmentSize {
+		http.Error(w, "attachment is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := ledgerClient.UploadAttachment(context.Background(), &pb_ledger.UploadAttachmentRequest{
+		UserId:        valResp.UserId,
+		TransactionId: transactionID,
+		Filename:      header.Filename,
+		Data:          data,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func downloadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.ParseInt(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid id", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := ledgerClient.GetAttachment(context.Background(), &pb_ledger.GetAttachmentRequest{UserId: valResp.UserId, Id: id})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !resp.Success {
+		http.Error(w, resp.Message, http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.Attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resp.Attachment.Filename}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(resp.Data)))
+	w.Write(resp.Data)
+}
+
+func merchantsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListMerchants(context.Background(), &pb_ledger.ListMerchantsRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func createMerchantHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.CreateMerchantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.CreateMerchant(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func merchantAliasHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.MerchantAliasRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.AddMerchantAlias(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func topMerchantsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	resp, err := ledgerClient.GetTopMerchants(context.Background(), &pb_ledger.TopMerchantsRequest{
+		UserId: valResp.UserId,
+		From:   r.URL.Query().Get("from"),
+		To:     r.URL.Query().Get("to"),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func subscriptionsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListSubscriptions(context.Background(), &pb_ledger.ListSubscriptionsRequest{
+		UserId:  valResp.UserId,
+		Refresh: r.URL.Query().Get("refresh") == "true",
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func debtsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListDebts(context.Background(), &pb_ledger.ListDebtsRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func createDebtHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.CreateDebtRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.CreateDebt(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func debtPaymentHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.DebtPaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.RecordDebtPayment(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// debtScheduleHandler takes the debt id and an optional comma-separated list
+// of extra monthly payments to project, e.g. ?id=3&extra=5000,10000.
+func debtScheduleHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	id, err := strconv.ParseInt(q.Get("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid id", http.StatusBadRequest)
+		return
+	}
+	var extras []float64
+	if q.Get("extra") != "" {
+		for _, s := range strings.Split(q.Get("extra"), ",") {
+			v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err != nil {
+				http.Error(w, "invalid extra", http.StatusBadRequest)
+				return
+			}
+			extras = append(extras, v)
+		}
+	}
+
+	resp, err := ledgerClient.GetDebtSchedule(context.Background(), &pb_ledger.DebtScheduleRequest{
+		UserId:        valResp.UserId,
+		DebtId:        id,
+		ExtraPayments: extras,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func incomeHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.IncomeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.RecordIncome(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func envelopesHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.GetEnvelopes(context.Background(), &pb_ledger.GetEnvelopesRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func moveEnvelopeHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.EnvelopeMoveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.MoveEnvelope(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func categoryGroupsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListCategoryGroups(context.Background(), &pb_ledger.ListCategoryGroupsRequest{UserId: valResp.UserId})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// setCategoryGroupHandler replaces the categories of a group. An empty list
+// removes the group.
+func setCategoryGroupHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.CategoryGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.SetCategoryGroup(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func expenseReportsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := ledgerClient.ListExpenseReports(context.Background(), &pb_ledger.ListExpenseReportsRequest{UserId: valResp.UserId, Status: r.URL.Query().Get("status")})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// createExpenseReportHandler claims the given expenses, or every unclaimed
+// reimbursable expense when transaction_ids is empty.
+func createExpenseReportHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.CreateExpenseReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.CreateExpenseReport(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func expenseReportStatusHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.ExpenseReportStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.SetExpenseReportStatus(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// removeExpenseReportItemHandler takes an expense off a draft report.
+func removeExpenseReportItemHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.ExpenseReportItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.RemoveExpenseReportItem(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func reimburseExpenseReportHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.ReimburseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.ReimburseExpenseReport(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// refundHandler records a refund of an expense; a zero amount refunds all of
+// what is left of it.
+func refundHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.RefundRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.RecordRefund(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func transactionsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	q := r.URL.Query()
+	limit, _ := strconv.Atoi(q.Get("limit"))
+
+	resp, err := ledgerClient.ListTransactions(context.Background(), &pb_ledger.ListTransactionsRequest{
+		UserId: valResp.UserId,
+		From:   q.Get("from"),
+		To:     q.Get("to"),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+func setRefundAttributionHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req pb_ledger.SetRefundAttributionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req.UserId = valResp.UserId
+
+	resp, err := ledgerClient.SetRefundAttribution(context.Background(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// eventsHandler streams ledger events as Server-Sent Events. The token is
+// only accepted in the Authorization header, never in the URL where it would
+// end up in logs; reconnects resume from the Last-Event-ID header. A
+// stream.reset event means events were missed and the client should reload.
+func eventsHandler(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	valResp, err := authClient.Validate(context.Background(), &pb_auth.ValidateRequest{Token: token})
+	if err != nil || !valResp.Valid {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	lastID := r.Header.Get("Last-Event-ID")
+	if lastID == "" {
+		lastID = r.URL.Query().Get("last_event_id")
+	}
+
+	stream, err := ledgerClient.WatchLedger(r.Context(), &pb_ledger.WatchRequest{UserId: valResp.UserId, LastEventId: lastID})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	events := make(chan *pb_ledger.LedgerEvent)
+	go func() {
+		defer close(events)
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case events <- e:
+			case <-r.Context().Done():
+				return
+			}
+		}
+	}()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	ping := time.NewTicker(20 * time.Second)
+	defer ping.Stop()
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return
+			}
+			data, _ := json.Marshal(e)
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.Id, e.Type, data)
+		case <-ping.C:
+			fmt.Fprint(w, ": ping\n\n")
+		case <-r.Context().Done():
+			return
+		}
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	pb_ledger "github.com/yuramishin/expense-tracker/proto/pb_ledger"
+)
+
+func TestWritePivotCSV(t *testing.T) {
+	p := &pb_ledger.PivotReport{
+		Periods: []string{"2026-01"},
+		Rows: []*pb_ledger.PivotRow{
+			{Category: "=HYPERLINK(\"http://x\")", Values: []float64{10}, Total: 10},
+			{Category: "+7", Values: []float64{20}, Total: 20},
+			{Category: "-кэшбэк", Values: []float64{30}, Total: 30},
+			{Category: "@SUM(A1)", Values: []float64{40}, Total: 40},
+			{Category: "\t=1+1", Values: []float64{1}, Total: 1},
+			{Category: "\r=1+1", Values: []float64{2}, Total: 2},
+			{Category: "Еда", Values: []float64{50.5}, Total: 50.5},
+		},
+		ColumnTotals: []float64{153.5},
+		GrandTotal:   153.5,
+	}
+
+	var b strings.Builder
+	writePivotCSV(&b, p)
+
+	want := "category,2026-01,total\n" +
+		"\"'=HYPERLINK(\"\"http://x\"\")\",10.00,10.00\n" +
+		"'+7,20.00,20.00\n" +
+		"'-кэшбэк,30.00,30.00\n" +
+		"'@SUM(A1),40.00,40.00\n" +
+		"'\t=1+1,1.00,1.00\n" +
+		"\"'\r=1+1\",2.00,2.00\n" +
+		"Еда,50.50,50.50\n" +
+		"total,153.50,153.50\n"
+	if b.String() != want {
+		t.Errorf("writePivotCSV() =\n%s\nwant\n%s", b.String(), want)
+	}
+}
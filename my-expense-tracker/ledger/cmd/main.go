@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"net"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
@@ -19,6 +21,15 @@ import (
 func main() {
 	cfg := config.Load()
 
+	defaultZone, err := time.LoadLocation(cfg.DefaultTZ)
+	if err != nil {
+		log.Fatalf("Invalid DEFAULT_TIMEZONE: %v", err)
+	}
+	scanEvery, err := time.ParseDuration(cfg.ScanEvery)
+	if err != nil || scanEvery <= 0 {
+		log.Fatalf("Invalid SUBSCRIPTION_SCAN_INTERVAL: %q", cfg.ScanEvery)
+	}
+
 	db, err := sql.Open("postgres", cfg.DatabaseURL)
 	if err != nil {
 		log.Fatal(err)
@@ -43,15 +54,22 @@ func main() {
 
 	pgRepo := repository.NewPostgresRepo(db)
 	redisRepo := repository.NewRedisRepo(rdb)
-	svc := service.NewLedgerService(pgRepo, redisRepo)
+	blobs, err := repository.NewLocalBlobStore(cfg.BlobDir)
+	if err != nil {
+		log.Fatalf("Attachments storage: %v", err)
+	}
+	svc := service.NewLedgerService(pgRepo, redisRepo, blobs, defaultZone)
 	grpcHandler := handler.NewGrpcHandler(svc)
 
+	go svc.RunEventPublisher(context.Background())
+	go svc.RunSubscriptionScan(context.Background(), scanEvery)
+
 	lis, err := net.Listen("tcp", cfg.GRPCPort)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.MaxRecvMsgSize(service.MaxAttachmentSize + 1<<20))
 	pb.RegisterLedgerServiceServer(s, grpcHandler)
 
 	log.Printf("Ledger Service running on %s", cfg.GRPCPort)
@@ -63,4 +81,84 @@ func main() {
 func initDB(db *sql.DB) {
 	db.Exec(`CREATE TABLE IF NOT EXISTS transactions (id SERIAL PRIMARY KEY, user_id INT, amount FLOAT, category TEXT, description TEXT, created_at TIMESTAMP DEFAULT NOW())`)
 	db.Exec(`CREATE TABLE IF NOT EXISTS budgets (id SERIAL PRIMARY KEY, user_id INT, category TEXT, limit_amount FLOAT, UNIQUE(user_id, category))`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS occurred_at TIMESTAMPTZ`)
+	db.Exec(`UPDATE transactions SET occurred_at = created_at WHERE occurred_at IS NULL`)
+	db.Exec(`ALTER TABLE transactions ALTER COLUMN occurred_at SET DEFAULT NOW()`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_user_occurred_at ON transactions (user_id, occurred_at)`)
+	db.Exec(`CREATE SEQUENCE IF NOT EXISTS transaction_changes`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS row_uuid TEXT`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS change_seq BIGINT`)
+	db.Exec(`UPDATE transactions SET change_seq = nextval('transaction_changes') WHERE change_seq IS NULL`)
+	db.Exec(`ALTER TABLE transactions ALTER COLUMN change_seq SET DEFAULT nextval('transaction_changes')`)
+	db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS transactions_user_row_uuid ON transactions (user_id, row_uuid) WHERE row_uuid IS NOT NULL`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_user_change_seq ON transactions (user_id, change_seq)`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS transaction_tombstones (id SERIAL PRIMARY KEY, user_id INT, transaction_id INT, row_uuid TEXT, deleted_at TIMESTAMPTZ DEFAULT NOW(), change_seq BIGINT DEFAULT nextval('transaction_changes'))`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'expense'`)
+	// Sync reads changes in the order of the transactions that made them, so
+	// a change that commits late is not skipped by a client that already
+	// read later ones.
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS change_xid xid8 NOT NULL DEFAULT pg_current_xact_id()`)
+	db.Exec(`ALTER TABLE transaction_tombstones ADD COLUMN IF NOT EXISTS change_xid xid8 NOT NULL DEFAULT pg_current_xact_id()`)
+	db.Exec(`ALTER TABLE transaction_tombstones ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'expense'`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_user_change_xid ON transactions (user_id, change_xid, change_seq)`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transaction_tombstones_user_change_xid ON transaction_tombstones (user_id, change_xid, change_seq)`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS goal_id INT`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fiscal_key TEXT`)
+	db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS transactions_user_fiscal_key ON transactions (user_id, fiscal_key) WHERE fiscal_key IS NOT NULL`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('russian', COALESCE(description, ''))) STORED`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_search ON transactions USING GIN (search_vector)`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS merchants (id SERIAL PRIMARY KEY, user_id INT, name TEXT, UNIQUE(user_id, name))`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS merchant_aliases (id SERIAL PRIMARY KEY, merchant_id INT REFERENCES merchants (id) ON DELETE CASCADE, pattern TEXT, UNIQUE(merchant_id, pattern))`)
+	db.Exec(`ALTER TABLE merchant_aliases DROP COLUMN IF EXISTS auto`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS merchant_id INT`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_user_merchant ON transactions (user_id, merchant_id)`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS subscriptions (id SERIAL PRIMARY KEY, user_id INT, key TEXT, name TEXT, category TEXT, cadence TEXT, amount DECIMAL, previous_amount DECIMAL, occurrences INT, last_charge TIMESTAMPTZ, next_charge TIMESTAMPTZ, yearly_cost DECIMAL, last_transaction_id INT, price_changed_at TIMESTAMPTZ, UNIQUE(user_id, key))`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS subscription_price_alerts (id SERIAL PRIMARY KEY, user_id INT, subscription_key TEXT, transaction_id INT, amount DECIMAL, previous_amount DECIMAL, created_at TIMESTAMPTZ DEFAULT NOW(), UNIQUE(user_id, subscription_key, transaction_id))`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS debts (id SERIAL PRIMARY KEY, user_id INT, counterparty TEXT, direction TEXT, principal DECIMAL, annual_rate DECIMAL, start_date DATE, term_months INT, payment DECIMAL, created_at TIMESTAMPTZ DEFAULT NOW())`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS debt_id INT`)
+	db.Exec(`ALTER TABLE budgets ADD COLUMN IF NOT EXISTS rollover TEXT NOT NULL DEFAULT 'reset'`)
+	db.Exec(`ALTER TABLE budgets ADD COLUMN IF NOT EXISTS rollover_cap FLOAT NOT NULL DEFAULT 0`)
+	db.Exec(`ALTER TABLE budgets ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ DEFAULT NOW()`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS budget_periods (id SERIAL PRIMARY KEY, user_id INT, category TEXT, period_start DATE, base_limit FLOAT, carryover FLOAT, spent FLOAT, closed BOOLEAN NOT NULL DEFAULT FALSE, UNIQUE(user_id, category, period_start))`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS budget_limits (id SERIAL PRIMARY KEY, user_id INT, category TEXT, limit_amount FLOAT, effective_from DATE, created_at TIMESTAMPTZ DEFAULT NOW(), UNIQUE(user_id, category, effective_from))`)
+	db.Exec(`INSERT INTO budget_limits (user_id, category, limit_amount, effective_from)
+		SELECT user_id, category, limit_amount, DATE '1970-01-01' FROM budgets b
+		WHERE NOT EXISTS (SELECT 1 FROM budget_limits l WHERE l.user_id = b.user_id AND l.category = b.category)`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}'`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_tags ON transactions USING GIN (tags)`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS category_groups (id SERIAL PRIMARY KEY, user_id INT, name TEXT, category TEXT, UNIQUE(user_id, name, category))`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS reimbursable BOOLEAN NOT NULL DEFAULT FALSE`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS reimbursed BOOLEAN NOT NULL DEFAULT FALSE`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS expense_report_id INT`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS expense_reports (id SERIAL PRIMARY KEY, user_id INT, title TEXT, status TEXT NOT NULL DEFAULT 'draft', created_at TIMESTAMPTZ DEFAULT NOW(), submitted_at TIMESTAMPTZ, approved_at TIMESTAMPTZ, paid_at TIMESTAMPTZ, reimbursement_id INT, reimbursed_amount FLOAT)`)
+	db.Exec(`UPDATE transactions SET expense_report_id = NULL WHERE expense_report_id NOT IN (SELECT id FROM expense_reports)`)
+	db.Exec(`UPDATE transactions SET reimbursed = FALSE WHERE expense_report_id IN (
+		SELECT id FROM expense_reports WHERE reimbursement_id NOT IN (SELECT id FROM transactions))`)
+	db.Exec(`UPDATE expense_reports SET status = 'approved', paid_at = NULL, reimbursement_id = NULL, reimbursed_amount = NULL
+		WHERE reimbursement_id NOT IN (SELECT id FROM transactions)`)
+	db.Exec(`ALTER TABLE transactions ADD CONSTRAINT transactions_expense_report_id_fkey FOREIGN KEY (expense_report_id) REFERENCES expense_reports (id)`)
+	db.Exec(`ALTER TABLE expense_reports ADD CONSTRAINT expense_reports_reimbursement_id_fkey FOREIGN KEY (reimbursement_id) REFERENCES transactions (id)`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_expense_report ON transactions (expense_report_id)`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS expense_reports_reimbursement ON expense_reports (reimbursement_id)`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS refund_of INT REFERENCES transactions (id)`)
+	db.Exec(`ALTER TABLE transactions ADD COLUMN IF NOT EXISTS applies_at TIMESTAMPTZ`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS transactions_refund_of ON transactions (refund_of)`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS budget_templates (id SERIAL PRIMARY KEY, user_id INT, name TEXT, created_at TIMESTAMPTZ DEFAULT NOW(), UNIQUE(user_id, name))`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS budget_template_items (id SERIAL PRIMARY KEY, template_id INT REFERENCES budget_templates (id) ON DELETE CASCADE, category TEXT, limit_amount FLOAT, UNIQUE(template_id, category))`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS envelope_moves (id SERIAL PRIMARY KEY, user_id INT, from_category TEXT, to_category TEXT, amount DECIMAL, note TEXT, created_at TIMESTAMPTZ DEFAULT NOW())`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS envelope_moves_user ON envelope_moves (user_id, created_at)`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS goals (id SERIAL PRIMARY KEY, user_id INT, name TEXT, target_amount DECIMAL, deadline DATE, created_at TIMESTAMPTZ DEFAULT NOW())`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS attachments (id SERIAL PRIMARY KEY, user_id INT, transaction_id INT REFERENCES transactions (id) ON DELETE CASCADE, filename TEXT, content_type TEXT, size BIGINT, storage_key TEXT, created_at TIMESTAMPTZ DEFAULT NOW())`)
+	db.Exec(`DELETE FROM attachments WHERE transaction_id NOT IN (SELECT id FROM transactions)`)
+	db.Exec(`ALTER TABLE attachments ADD CONSTRAINT attachments_transaction_id_fkey FOREIGN KEY (transaction_id) REFERENCES transactions (id) ON DELETE CASCADE`)
+	db.Exec(`CREATE INDEX IF NOT EXISTS attachments_user_transaction ON attachments (user_id, transaction_id)`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS user_settings (user_id INT PRIMARY KEY, timezone TEXT NOT NULL DEFAULT 'UTC')`)
+	db.Exec(`ALTER TABLE user_settings ALTER COLUMN timezone DROP NOT NULL`)
+	db.Exec(`ALTER TABLE user_settings ALTER COLUMN timezone DROP DEFAULT`)
+	db.Exec(`ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS budget_mode TEXT NOT NULL DEFAULT 'limits'`)
+	db.Exec(`ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS envelopes_since TIMESTAMPTZ`)
+	db.Exec(`ALTER TABLE user_settings ADD COLUMN IF NOT EXISTS refund_attribution TEXT NOT NULL DEFAULT 'purchase'`)
+	db.Exec(`CREATE TABLE IF NOT EXISTS anomalies (id SERIAL PRIMARY KEY, user_id INT, transaction_id INT REFERENCES transactions(id), score FLOAT, reasons TEXT[], reviewed BOOLEAN DEFAULT FALSE, created_at TIMESTAMP DEFAULT NOW())`)
 }
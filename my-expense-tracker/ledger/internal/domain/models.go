@@ -8,12 +8,557 @@ type Transaction struct {
 	Amount      float64
 	Category    string
 	Description string
+	OccurredAt  time.Time
 	CreatedAt   time.Time
+	RowUUID     string
+	Version     int
+	UpdatedAt   time.Time
+	Kind        string
+	GoalID      int64
+	FiscalKey   string
+	MerchantID  int64
+	DebtID      int64
+	Tags        []string
+
+	Reimbursable    bool
+	Reimbursed      bool
+	ExpenseReportID int64
+
+	// RefundOf is the expense a refund returns money for, and AppliesAt when
+	// the refund reduces spending. RefundedAmount is what has been refunded of
+	// an expense.
+	RefundOf       int64
+	AppliesAt      time.Time
+	RefundedAmount float64
 }
 
+// Transaction kinds. Only expenses count as spending, and only until they
+// are reimbursed; refunds reduce it.
+const (
+	KindExpense       = "expense"
+	KindSaving        = "saving"
+	KindDebtPayment   = "debt_payment"
+	KindIncome        = "income"
+	KindReimbursement = "reimbursement"
+	KindRefund        = "refund"
+)
+
+// Refund attributions: a refund reduces spending in the month of the
+// original purchase or in the month the money came back.
+const (
+	RefundToPurchase = "purchase"
+	RefundToRefund   = "refund"
+)
+
+// Rollover policies decide what a budget passes to the next month: nothing,
+// the unspent remainder, the overspending as a deduction, or either.
+const (
+	RolloverReset     = "reset"
+	RolloverUnspent   = "unspent"
+	RolloverOverspent = "overspent"
+	RolloverBoth      = "both"
+)
+
+// Budget scopes. A budget's Category is its scope: a category name, ScopeAll
+// for all spending, a category group or a tag.
+const (
+	ScopeAll         = "*"
+	ScopeGroupPrefix = "group:"
+	ScopeTagPrefix   = "tag:"
+)
+
 type Budget struct {
 	ID          int64
 	UserID      int64
 	Category    string
 	LimitAmount float64
+	Rollover    string
+	RolloverCap float64
+	CreatedAt   time.Time
+
+	PeriodStart    time.Time
+	Carryover      float64
+	EffectiveLimit float64
+}
+
+// BudgetLimit is a change of a budget's limit. A month uses the latest change
+// effective on or before its last day; of several changes effective in the
+// same month, the one recorded last wins.
+type BudgetLimit struct {
+	Category      string
+	LimitAmount   float64
+	EffectiveFrom time.Time
+	CreatedAt     time.Time
+}
+
+// BudgetTemplate is a named set of category limits that can be applied to a
+// month in one go.
+type BudgetTemplate struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	Items     []*BudgetTemplateItem
+	CreatedAt time.Time
+}
+
+type BudgetTemplateItem struct {
+	Category    string
+	LimitAmount float64
+}
+
+// BudgetChange is the effect of applying a template or the previous month's
+// budgets on one category.
+type BudgetChange struct {
+	Category      string
+	HadLimit      bool
+	PreviousLimit float64
+	NewLimit      float64
+}
+
+type BudgetPlan struct {
+	PeriodStart time.Time
+	Source      string
+	Applied     bool
+	Changes     []*BudgetChange
+}
+
+// BudgetPeriod is a month of a budget's history. A closed period's spending
+// is final and its remainder has been carried to the next month.
+type BudgetPeriod struct {
+	Category    string
+	PeriodStart time.Time
+	BaseLimit   float64
+	Carryover   float64
+	Spent       float64
+	Closed      bool
+}
+
+type CategoryForecast struct {
+	Category         string
+	Spent            float64
+	Projected        float64
+	RecurringPending float64
+	LimitAmount      float64
+	HasBudget        bool
+	LikelyOverspend  bool
+}
+
+type Forecast struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	DaysElapsed int
+	DaysTotal   int
+	Categories  []*CategoryForecast
+}
+
+type TransactionResult struct {
+	Success        bool
+	Message        string
+	TransactionID  int64
+	AnomalyScore   float64
+	AnomalyReasons []string
+}
+
+type Anomaly struct {
+	ID            int64
+	UserID        int64
+	TransactionID int64
+	Amount        float64
+	Category      string
+	Description   string
+	Score         float64
+	Reasons       []string
+	Reviewed      bool
+	CreatedAt     time.Time
+}
+
+type CategoryComparison struct {
+	Category          string
+	Current           float64
+	Previous          float64
+	YearAgo           float64
+	ChangePrevious    float64
+	ChangePreviousPct float64
+	ChangeYearAgo     float64
+	ChangeYearAgoPct  float64
+	// NewPrevious and NewYearAgo mark spending with nothing to compare to,
+	// whose percentage change is left at 0.
+	NewPrevious bool
+	NewYearAgo  bool
+}
+
+type Comparison struct {
+	Month      time.Time
+	Previous   time.Time
+	YearAgo    time.Time
+	Categories []*CategoryComparison
+	TopMovers  []*CategoryComparison
+	Newcomers  []*CategoryComparison
+}
+
+type MonthlyTotal struct {
+	Month    time.Time
+	Category string
+	Amount   float64
+}
+
+type PivotRow struct {
+	Category string
+	Values   []float64
+	Total    float64
+}
+
+type PivotReport struct {
+	Periods      []time.Time
+	Rows         []*PivotRow
+	ColumnTotals []float64
+	GrandTotal   float64
+}
+
+type CategoryStats struct {
+	Category string
+	Count    int64
+	Total    float64
+	Mean     float64
+	Median   float64
+	Min      float64
+	Max      float64
+	P90      float64
+}
+
+type WeekdayStats struct {
+	Weekday int
+	Count   int64
+	Total   float64
+	Average float64
+}
+
+type Statistics struct {
+	From              time.Time
+	To                time.Time
+	Count             int64
+	Total             float64
+	AverageDailySpend float64
+	Categories        []*CategoryStats
+	Weekdays          []*WeekdayStats
+}
+
+type BatchRow struct {
+	Row         int
+	Transaction *Transaction
+	Error       string
+}
+
+type BatchRowResult struct {
+	Row            int
+	Success        bool
+	Message        string
+	TransactionID  int64
+	AnomalyScore   float64
+	AnomalyReasons []string
+}
+
+type BatchResult struct {
+	Inserted int
+	Failed   int
+	// Skipped counts rows that an earlier request already imported.
+	Skipped int
+	Results []*BatchRowResult
+}
+
+const (
+	EventTransactionCreated = "transaction.created"
+	EventTransactionUpdated = "transaction.updated"
+	EventTransactionDeleted = "transaction.deleted"
+	EventBudgetChanged      = "budget.changed"
+	EventBudgetThreshold    = "budget.threshold"
+	EventSubscriptionPrice  = "subscription.price_increased"
+	// EventStreamReset tells a resuming client that events it has not seen
+	// are gone and it should reload its state.
+	EventStreamReset = "stream.reset"
+)
+
+type LedgerEvent struct {
+	ID             string
+	Type           string
+	CreatedAt      time.Time
+	TransactionID  int64
+	Amount         float64
+	Category       string
+	Description    string
+	LimitAmount    float64
+	Spent          float64
+	Threshold      float64
+	PreviousAmount float64
+}
+
+const (
+	SyncCreated   = "created"
+	SyncUpdated   = "updated"
+	SyncDeleted   = "deleted"
+	SyncUnchanged = "unchanged"
+	SyncConflict  = "conflict"
+	SyncError     = "error"
+)
+
+const (
+	ConflictServerWins = "server_wins"
+	ConflictClientWins = "client_wins"
+	ConflictLatestWins = "latest_wins"
+)
+
+// SyncRow is one transaction as seen by a sync client. Version is the server
+// version the client last saw, and ModifiedAt is when the client changed it.
+type SyncRow struct {
+	Row         int
+	Transaction *Transaction
+	Deleted     bool
+	ModifiedAt  time.Time
+	Error       string
+}
+
+type SyncRowResult struct {
+	Row     int
+	Status  string
+	Message string
+	Server  *SyncRow
+}
+
+type Change struct {
+	Transaction *Transaction
+	Deleted     bool
+	XID         uint64
+	Seq         int64
+}
+
+type Goal struct {
+	ID           int64
+	UserID       int64
+	Name         string
+	TargetAmount float64
+	Deadline     time.Time
+	CreatedAt    time.Time
+}
+
+type GoalProgress struct {
+	Goal                *Goal
+	Saved               float64
+	Remaining           float64
+	Percent             float64
+	MonthsLeft          int
+	RequiredMonthly     float64
+	AverageMonthly      float64
+	ProjectedCompletion time.Time
+	OnTrack             bool
+	Completed           bool
+}
+
+type Attachment struct {
+	ID            int64
+	UserID        int64
+	TransactionID int64
+	Filename      string
+	ContentType   string
+	Size          int64
+	StorageKey    string
+	CreatedAt     time.Time
+}
+
+// Receipt holds the fields encoded in a Russian fiscal receipt QR code.
+type Receipt struct {
+	OccurredAt time.Time
+	Amount     float64
+	FN         string
+	FD         string
+	FP         string
+	Operation  int
+}
+
+// FiscalKey identifies the receipt: the fiscal drive number, document number
+// and fiscal sign together are unique.
+func (r *Receipt) FiscalKey() string {
+	return r.FN + ":" + r.FD + ":" + r.FP
+}
+
+type SearchQuery struct {
+	Text     string
+	Category string
+	From     time.Time
+	To       time.Time
+	Limit    int
+	Offset   int
+}
+
+type SearchResult struct {
+	Transaction *Transaction
+	Rank        float64
+	Highlight   string
+}
+
+type Merchant struct {
+	ID      int64
+	UserID  int64
+	Name    string
+	Aliases []string
+}
+
+type MerchantAlias struct {
+	MerchantID int64
+	Pattern    string
+}
+
+type MerchantStats struct {
+	MerchantID int64
+	Name       string
+	Total      float64
+	Visits     int
+	Average    float64
+}
+
+type MerchantReport struct {
+	From      time.Time
+	To        time.Time
+	Merchants []*MerchantStats
+}
+
+// Subscription cadences.
+const (
+	CadenceWeekly    = "weekly"
+	CadenceMonthly   = "monthly"
+	CadenceQuarterly = "quarterly"
+	CadenceYearly    = "yearly"
+)
+
+type Subscription struct {
+	ID             int64
+	UserID         int64
+	Key            string
+	Name           string
+	Category       string
+	Cadence        string
+	Amount         float64
+	PreviousAmount float64
+	Occurrences    int
+	LastCharge     time.Time
+	NextCharge     time.Time
+	YearlyCost     float64
+	LastID         int64
+	PriceChangedAt time.Time
+}
+
+// Debt directions: money borrowed from the counterparty or lent to them.
+const (
+	DebtBorrowed = "borrowed"
+	DebtLent     = "lent"
+)
+
+type Debt struct {
+	ID           int64
+	UserID       int64
+	Counterparty string
+	Direction    string
+	Principal    float64
+	AnnualRate   float64
+	StartDate    time.Time
+	TermMonths   int
+	Payment      float64
+	CreatedAt    time.Time
+}
+
+type AmortisationRow struct {
+	N         int
+	Date      time.Time
+	Payment   float64
+	Interest  float64
+	Principal float64
+	Balance   float64
+}
+
+type PayoffProjection struct {
+	ExtraPayment  float64
+	PaysOff       bool
+	PayoffDate    time.Time
+	Months        int
+	TotalInterest float64
+	MonthsSaved   int
+	InterestSaved float64
+}
+
+type DebtStatus struct {
+	Debt            *Debt
+	Payment         float64
+	PrincipalPaid   float64
+	InterestPaid    float64
+	Balance         float64
+	AccruedInterest float64
+	Outstanding     float64
+	Schedule        []*AmortisationRow
+	Projections     []*PayoffProjection
+}
+
+// Budget modes: static monthly limits per category, or zero-based envelopes
+// funded from recorded income.
+const (
+	BudgetModeLimits    = "limits"
+	BudgetModeEnvelopes = "envelopes"
+)
+
+// EnvelopeMove moves money between envelopes. An empty category is the
+// unassigned pool, so allocations have no FromCategory.
+type EnvelopeMove struct {
+	ID           int64
+	UserID       int64
+	FromCategory string
+	ToCategory   string
+	Amount       float64
+	Note         string
+	CreatedAt    time.Time
+}
+
+type Envelope struct {
+	Category  string
+	Allocated float64
+	Spent     float64
+	Balance   float64
+}
+
+type EnvelopeSummary struct {
+	Since      time.Time
+	Income     float64
+	Unassigned float64
+	Envelopes  []*Envelope
+	Moves      []*EnvelopeMove
+}
+
+// CategoryGroup is a named set of categories that can be budgeted together.
+type CategoryGroup struct {
+	Name       string
+	Categories []string
+}
+
+// Expense report statuses, in the order a claim goes through them.
+const (
+	ReportDraft     = "draft"
+	ReportSubmitted = "submitted"
+	ReportApproved  = "approved"
+	ReportPaid      = "paid"
+)
+
+// ExpenseReport claims reimbursable expenses back. Its expenses count as
+// personal spending while it is drafted, submitted and approved. When it is
+// paid the reimbursement is recorded as a ledger entry and the expenses are
+// marked reimbursed, which stops them counting.
+type ExpenseReport struct {
+	ID               int64
+	UserID           int64
+	Title            string
+	Status           string
+	Total            float64
+	ReimbursedAmount float64
+	ReimbursementID  int64
+	CreatedAt        time.Time
+	SubmittedAt      time.Time
+	ApprovedAt       time.Time
+	PaidAt           time.Time
+	Items            []*Transaction
 }
@@ -2,10 +2,17 @@ package handler
 
 import (
 	"context"
+	"errors"
+	"io"
+	"math"
+	"time"
 
 	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
 	"github.com/yuramishin/expense-tracker/ledger/internal/service"
 	pb "github.com/yuramishin/expense-tracker/proto/pb_ledger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type GrpcHandler struct {
@@ -18,14 +25,37 @@ func NewGrpcHandler(s *service.LedgerService) *GrpcHandler {
 }
 
 func (h *GrpcHandler) CreateTransaction(ctx context.Context, req *pb.TransactionRequest) (*pb.TransactionResponse, error) {
+	occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, req.OccurredAt)
+	if err != nil {
+		return &pb.TransactionResponse{Success: false, Message: err.Error()}, nil
+	}
+
 	t := &domain.Transaction{
-		UserID:      req.UserId,
-		Amount:      req.Amount,
-		Category:    req.Category,
-		Description: req.Description,
+		UserID:       req.UserId,
+		Amount:       req.Amount,
+		Category:     req.Category,
+		Description:  req.Description,
+		OccurredAt:   occurredAt,
+		Tags:         req.Tags,
+		Reimbursable: req.Reimbursable,
+	}
+	var body []byte
+	if req.IdempotencyKey != "" {
+		// The key itself is not part of the request it identifies.
+		r := proto.Clone(req).(*pb.TransactionRequest)
+		r.IdempotencyKey = ""
+		if body, err = (proto.MarshalOptions{Deterministic: true}).Marshal(r); err != nil {
+			return nil, err
+		}
 	}
-	success, msg := h.service.CreateTransaction(ctx, t)
-	return &pb.TransactionResponse{Success: success, Message: msg}, nil
+	res := h.service.CreateTransaction(ctx, t, req.IdempotencyKey, body)
+	return &pb.TransactionResponse{
+		Success:        res.Success,
+		Message:        res.Message,
+		TransactionId:  res.TransactionID,
+		AnomalyScore:   res.AnomalyScore,
+		AnomalyReasons: res.AnomalyReasons,
+	}, nil
 }
 
 func (h *GrpcHandler) GetReport(ctx context.Context, req *pb.ReportRequest) (*pb.ReportResponse, error) {
@@ -37,7 +67,7 @@ func (h *GrpcHandler) GetReport(ctx context.Context, req *pb.ReportRequest) (*pb
 }
 
 func (h *GrpcHandler) SetBudget(ctx context.Context, req *pb.BudgetRequest) (*pb.BudgetResponse, error) {
-	err := h.service.SetBudget(ctx, req.UserId, req.Category, req.LimitAmount)
+	err := h.service.SetBudget(ctx, req.UserId, req.Category, req.LimitAmount, req.EffectiveFrom, req.Rollover, req.RolloverCap)
 	if err != nil {
 		return &pb.BudgetResponse{Success: false, Message: err.Error()}, nil
 	}
@@ -52,7 +82,977 @@ func (h *GrpcHandler) GetBudgets(ctx context.Context, req *pb.GetBudgetsRequest)
 
 	var pbList []*pb.Budget
 	for _, b := range list {
-		pbList = append(pbList, &pb.Budget{Category: b.Category, LimitAmount: b.LimitAmount})
+		pbList = append(pbList, &pb.Budget{
+			Category:       b.Category,
+			LimitAmount:    b.LimitAmount,
+			Rollover:       b.Rollover,
+			RolloverCap:    b.RolloverCap,
+			Carryover:      b.Carryover,
+			EffectiveLimit: b.EffectiveLimit,
+			PeriodStart:    b.PeriodStart.Format("2006-01-02"),
+		})
 	}
 	return &pb.BudgetList{Budgets: pbList}, nil
 }
+
+func (h *GrpcHandler) GetBudgetHistory(ctx context.Context, req *pb.BudgetHistoryRequest) (*pb.BudgetHistory, error) {
+	list, err := h.service.GetBudgetHistory(ctx, req.UserId, req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.BudgetHistory{Category: req.Category}
+	for _, p := range list {
+		resp.Periods = append(resp.Periods, &pb.BudgetPeriod{
+			PeriodStart:    p.PeriodStart.Format("2006-01-02"),
+			BaseLimit:      p.BaseLimit,
+			Carryover:      p.Carryover,
+			EffectiveLimit: math.Round((p.BaseLimit+p.Carryover)*100) / 100,
+			Spent:          p.Spent,
+			Closed:         p.Closed,
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) ListBudgetLimits(ctx context.Context, req *pb.BudgetLimitsRequest) (*pb.BudgetLimitList, error) {
+	list, err := h.service.ListBudgetLimits(ctx, req.UserId, req.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.BudgetLimitList{}
+	for _, l := range list {
+		resp.Limits = append(resp.Limits, &pb.BudgetLimit{
+			Category:      l.Category,
+			LimitAmount:   l.LimitAmount,
+			EffectiveFrom: l.EffectiveFrom.Format("2006-01-02"),
+			CreatedAt:     l.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) SaveBudgetTemplate(ctx context.Context, req *pb.BudgetTemplateRequest) (*pb.BudgetTemplateResponse, error) {
+	items := make([]*domain.BudgetTemplateItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = &domain.BudgetTemplateItem{Category: item.Category, LimitAmount: item.LimitAmount}
+	}
+
+	t, err := h.service.SaveBudgetTemplate(ctx, req.UserId, req.Name, items, req.FromCurrent)
+	if err != nil {
+		return &pb.BudgetTemplateResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.BudgetTemplateResponse{Success: true, Message: "Template Saved", TemplateId: t.ID}, nil
+}
+
+func (h *GrpcHandler) ListBudgetTemplates(ctx context.Context, req *pb.ListBudgetTemplatesRequest) (*pb.BudgetTemplateList, error) {
+	list, err := h.service.ListBudgetTemplates(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.BudgetTemplateList{}
+	for _, t := range list {
+		tpl := &pb.BudgetTemplate{TemplateId: t.ID, Name: t.Name, CreatedAt: t.CreatedAt.Format(time.RFC3339)}
+		for _, item := range t.Items {
+			tpl.Items = append(tpl.Items, &pb.BudgetTemplateItem{Category: item.Category, LimitAmount: item.LimitAmount})
+		}
+		resp.Templates = append(resp.Templates, tpl)
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) SetCategoryGroup(ctx context.Context, req *pb.CategoryGroupRequest) (*pb.CategoryGroupResponse, error) {
+	if err := h.service.SetCategoryGroup(ctx, req.UserId, req.Name, req.Categories); err != nil {
+		return &pb.CategoryGroupResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.CategoryGroupResponse{Success: true, Message: "Group Saved"}, nil
+}
+
+func (h *GrpcHandler) ListCategoryGroups(ctx context.Context, req *pb.ListCategoryGroupsRequest) (*pb.CategoryGroupList, error) {
+	list, err := h.service.ListCategoryGroups(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CategoryGroupList{}
+	for _, g := range list {
+		resp.Groups = append(resp.Groups, &pb.CategoryGroup{Name: g.Name, Categories: g.Categories})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) ApplyBudgets(ctx context.Context, req *pb.ApplyBudgetsRequest) (*pb.ApplyBudgetsResponse, error) {
+	plan, err := h.service.ApplyBudgets(ctx, req.UserId, req.Template, req.Period, req.AdjustPercent, req.Preview)
+	if err != nil {
+		return &pb.ApplyBudgetsResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	resp := &pb.ApplyBudgetsResponse{
+		Success: true,
+		Message: "Budgets Applied",
+		Period:  plan.PeriodStart.Format("2006-01"),
+		Source:  plan.Source,
+		Applied: plan.Applied,
+	}
+	if !plan.Applied {
+		resp.Message = "Preview"
+	}
+	for _, c := range plan.Changes {
+		resp.Changes = append(resp.Changes, &pb.BudgetChange{
+			Category:      c.Category,
+			HadLimit:      c.HadLimit,
+			PreviousLimit: c.PreviousLimit,
+			NewLimit:      c.NewLimit,
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetForecast(ctx context.Context, req *pb.ForecastRequest) (*pb.ForecastResponse, error) {
+	f, err := h.service.GetForecast(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ForecastResponse{
+		PeriodStart: f.PeriodStart.Format("2006-01-02"),
+		PeriodEnd:   f.PeriodEnd.Format("2006-01-02"),
+		DaysElapsed: int32(f.DaysElapsed),
+		DaysTotal:   int32(f.DaysTotal),
+	}
+	for _, c := range f.Categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryForecast{
+			Category:         c.Category,
+			Spent:            c.Spent,
+			Projected:        c.Projected,
+			RecurringPending: c.RecurringPending,
+			LimitAmount:      c.LimitAmount,
+			HasBudget:        c.HasBudget,
+			LikelyOverspend:  c.LikelyOverspend,
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) ListAnomalies(ctx context.Context, req *pb.ListAnomaliesRequest) (*pb.AnomalyList, error) {
+	list, err := h.service.ListAnomalies(ctx, req.UserId, req.IncludeReviewed)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbList []*pb.Anomaly
+	for _, a := range list {
+		pbList = append(pbList, &pb.Anomaly{
+			Id:            a.ID,
+			TransactionId: a.TransactionID,
+			Amount:        a.Amount,
+			Category:      a.Category,
+			Description:   a.Description,
+			Score:         a.Score,
+			Reasons:       a.Reasons,
+			Reviewed:      a.Reviewed,
+			CreatedAt:     a.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return &pb.AnomalyList{Anomalies: pbList}, nil
+}
+
+func (h *GrpcHandler) ReviewAnomaly(ctx context.Context, req *pb.ReviewAnomalyRequest) (*pb.ReviewAnomalyResponse, error) {
+	if err := h.service.ReviewAnomaly(ctx, req.UserId, req.Id); err != nil {
+		return &pb.ReviewAnomalyResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.ReviewAnomalyResponse{Success: true, Message: "Reviewed"}, nil
+}
+
+func (h *GrpcHandler) CompareReport(ctx context.Context, req *pb.CompareRequest) (*pb.CompareResponse, error) {
+	c, err := h.service.CompareReport(ctx, req.UserId, req.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CompareResponse{
+		Month:         c.Month.Format("2006-01"),
+		PreviousMonth: c.Previous.Format("2006-01"),
+		YearAgoMonth:  c.YearAgo.Format("2006-01"),
+	}
+	for _, cc := range c.Categories {
+		resp.Categories = append(resp.Categories, toPbComparison(cc))
+	}
+	for _, cc := range c.TopMovers {
+		resp.TopMovers = append(resp.TopMovers, toPbComparison(cc))
+	}
+	for _, cc := range c.Newcomers {
+		resp.Newcomers = append(resp.Newcomers, toPbComparison(cc))
+	}
+	return resp, nil
+}
+
+func toPbComparison(c *domain.CategoryComparison) *pb.CategoryComparison {
+	return &pb.CategoryComparison{
+		Category:          c.Category,
+		Current:           c.Current,
+		Previous:          c.Previous,
+		YearAgo:           c.YearAgo,
+		ChangePrevious:    c.ChangePrevious,
+		ChangePreviousPct: c.ChangePreviousPct,
+		ChangeYearAgo:     c.ChangeYearAgo,
+		ChangeYearAgoPct:  c.ChangeYearAgoPct,
+		NewPrevious:       c.NewPrevious,
+		NewYearAgo:        c.NewYearAgo,
+	}
+}
+
+func (h *GrpcHandler) GetPivotReport(ctx context.Context, req *pb.PivotRequest) (*pb.PivotReport, error) {
+	p, err := h.service.GetPivotReport(ctx, req.UserId, req.FromMonth, req.ToMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.PivotReport{ColumnTotals: p.ColumnTotals, GrandTotal: p.GrandTotal}
+	for _, m := range p.Periods {
+		resp.Periods = append(resp.Periods, m.Format("2006-01"))
+	}
+	for _, row := range p.Rows {
+		resp.Rows = append(resp.Rows, &pb.PivotRow{Category: row.Category, Values: row.Values, Total: row.Total})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetStatistics(ctx context.Context, req *pb.StatisticsRequest) (*pb.StatisticsResponse, error) {
+	stats, err := h.service.GetStatistics(ctx, req.UserId, req.From, req.To)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.StatisticsResponse{
+		From:              stats.From.Format("2006-01-02"),
+		To:                stats.To.Format("2006-01-02"),
+		Count:             stats.Count,
+		Total:             stats.Total,
+		AverageDailySpend: stats.AverageDailySpend,
+	}
+	for _, c := range stats.Categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryStats{
+			Category: c.Category,
+			Count:    c.Count,
+			Total:    c.Total,
+			Mean:     c.Mean,
+			Median:   c.Median,
+			Min:      c.Min,
+			Max:      c.Max,
+			P90:      c.P90,
+		})
+	}
+	for _, w := range stats.Weekdays {
+		resp.Weekdays = append(resp.Weekdays, &pb.WeekdayStats{
+			Weekday: int32(w.Weekday),
+			Count:   w.Count,
+			Total:   w.Total,
+			Average: w.Average,
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetSettings(ctx context.Context, req *pb.GetSettingsRequest) (*pb.UserSettings, error) {
+	return &pb.UserSettings{
+		Timezone:          h.service.GetTimezone(ctx, req.UserId),
+		BudgetMode:        h.service.GetBudgetMode(ctx, req.UserId),
+		RefundAttribution: h.service.GetRefundAttribution(ctx, req.UserId),
+	}, nil
+}
+
+func (h *GrpcHandler) SetTimezone(ctx context.Context, req *pb.SetTimezoneRequest) (*pb.SettingsResponse, error) {
+	if err := h.service.SetTimezone(ctx, req.UserId, req.Timezone); err != nil {
+		return &pb.SettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.SettingsResponse{Success: true, Message: "Timezone Set"}, nil
+}
+
+func (h *GrpcHandler) SetBudgetMode(ctx context.Context, req *pb.SetBudgetModeRequest) (*pb.SettingsResponse, error) {
+	if err := h.service.SetBudgetMode(ctx, req.UserId, req.BudgetMode); err != nil {
+		return &pb.SettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.SettingsResponse{Success: true, Message: "Budget Mode Set"}, nil
+}
+
+func (h *GrpcHandler) CreateTransactions(stream pb.LedgerService_CreateTransactionsServer) error {
+	var userID int64
+	var atomic bool
+	var rows []*domain.BatchRow
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(rows) == service.BatchMaxRows {
+			return status.Errorf(codes.InvalidArgument, "batch is limited to %d rows", service.BatchMaxRows)
+		}
+
+		req := item.Transaction
+		if req == nil {
+			req = &pb.TransactionRequest{}
+		}
+		if len(rows) == 0 {
+			userID = req.UserId
+			atomic = item.Atomic
+		}
+
+		row := &domain.BatchRow{
+			Row: int(item.Row),
+			Transaction: &domain.Transaction{
+				UserID:       userID,
+				Amount:       req.Amount,
+				Category:     req.Category,
+				Description:  req.Description,
+				Tags:         req.Tags,
+				Reimbursable: req.Reimbursable,
+				RowUUID:      item.RowUuid,
+			},
+		}
+		if req.UserId != userID {
+			row.Error = "all rows must belong to the same user"
+		} else if occurredAt, err := h.service.ParseOccurredAt(stream.Context(), userID, req.OccurredAt); err != nil {
+			row.Error = err.Error()
+		} else {
+			row.Transaction.OccurredAt = occurredAt
+		}
+		rows = append(rows, row)
+	}
+
+	res := h.service.CreateTransactions(stream.Context(), userID, rows, atomic)
+
+	resp := &pb.BatchTransactionResponse{Inserted: int32(res.Inserted), Failed: int32(res.Failed), Skipped: int32(res.Skipped)}
+	for _, r := range res.Results {
+		resp.Results = append(resp.Results, &pb.BatchRowResult{
+			Row:            int32(r.Row),
+			Success:        r.Success,
+			Message:        r.Message,
+			TransactionId:  r.TransactionID,
+			AnomalyScore:   r.AnomalyScore,
+			AnomalyReasons: r.AnomalyReasons,
+		})
+	}
+	return stream.SendAndClose(resp)
+}
+
+func (h *GrpcHandler) WatchLedger(req *pb.WatchRequest, stream pb.LedgerService_WatchLedgerServer) error {
+	return h.service.WatchLedger(stream.Context(), req.UserId, req.LastEventId, func(e *domain.LedgerEvent) error {
+		return stream.Send(&pb.LedgerEvent{
+			Id:             e.ID,
+			Type:           e.Type,
+			CreatedAt:      e.CreatedAt.Format(time.RFC3339),
+			TransactionId:  e.TransactionID,
+			Amount:         e.Amount,
+			Category:       e.Category,
+			Description:    e.Description,
+			LimitAmount:    e.LimitAmount,
+			Spent:          e.Spent,
+			Threshold:      e.Threshold,
+			PreviousAmount: e.PreviousAmount,
+		})
+	})
+}
+
+func (h *GrpcHandler) UpdateTransaction(ctx context.Context, req *pb.UpdateTransactionRequest) (*pb.TransactionResponse, error) {
+	t := &domain.Transaction{
+		ID:          req.Id,
+		UserID:      req.UserId,
+		Amount:      req.Amount,
+		Category:    req.Category,
+		Description: req.Description,
+	}
+	if req.OccurredAt != "" {
+		occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, req.OccurredAt)
+		if err != nil {
+			return &pb.TransactionResponse{Success: false, Message: err.Error()}, nil
+		}
+		t.OccurredAt = occurredAt
+	}
+
+	res := h.service.UpdateTransaction(ctx, t)
+	return &pb.TransactionResponse{Success: res.Success, Message: res.Message, TransactionId: res.TransactionID}, nil
+}
+
+func (h *GrpcHandler) DeleteTransaction(ctx context.Context, req *pb.DeleteTransactionRequest) (*pb.TransactionResponse, error) {
+	res := h.service.DeleteTransaction(ctx, req.UserId, req.Id)
+	return &pb.TransactionResponse{Success: res.Success, Message: res.Message, TransactionId: res.TransactionID}, nil
+}
+
+func (h *GrpcHandler) SyncPush(ctx context.Context, req *pb.SyncPushRequest) (*pb.SyncPushResponse, error) {
+	var rows []*domain.SyncRow
+	for _, r := range req.Rows {
+		row := &domain.SyncRow{
+			Row:     int(r.Row),
+			Deleted: r.Deleted,
+			Transaction: &domain.Transaction{
+				ID:          r.TransactionId,
+				RowUUID:     r.RowUuid,
+				Amount:      r.Amount,
+				Category:    r.Category,
+				Description: r.Description,
+				Version:     int(r.Version),
+			},
+		}
+		if r.OccurredAt != "" {
+			occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, r.OccurredAt)
+			if err != nil {
+				row.Error = err.Error()
+			}
+			row.Transaction.OccurredAt = occurredAt
+		}
+		if r.UpdatedAt != "" {
+			modifiedAt, err := time.Parse(time.RFC3339, r.UpdatedAt)
+			if err != nil {
+				row.Error = "invalid updated_at, expected RFC 3339"
+			}
+			row.ModifiedAt = modifiedAt
+		}
+		rows = append(rows, row)
+	}
+
+	results, err := h.service.SyncPush(ctx, req.UserId, rows, req.ConflictPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SyncPushResponse{}
+	for _, r := range results {
+		res := &pb.SyncRowResult{Row: int32(r.Row), Status: r.Status, Message: r.Message}
+		if r.Server != nil {
+			res.Server = toPbSyncRow(r.Server.Transaction, r.Server.Deleted)
+			res.Server.Row = int32(r.Row)
+		}
+		resp.Results = append(resp.Results, res)
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetChanges(ctx context.Context, req *pb.ChangesRequest) (*pb.ChangesResponse, error) {
+	changes, token, more, err := h.service.GetChanges(ctx, req.UserId, req.SinceToken)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ChangesResponse{ChangeToken: token, HasMore: more}
+	for _, c := range changes {
+		resp.Changes = append(resp.Changes, toPbSyncRow(c.Transaction, c.Deleted))
+	}
+	return resp, nil
+}
+
+func toPbSyncRow(t *domain.Transaction, deleted bool) *pb.SyncRow {
+	row := &pb.SyncRow{
+		TransactionId: t.ID,
+		RowUuid:       t.RowUUID,
+		Deleted:       deleted,
+	}
+	if !deleted {
+		row.Amount = t.Amount
+		row.Category = t.Category
+		row.Description = t.Description
+		row.OccurredAt = t.OccurredAt.Format(time.RFC3339)
+		row.Version = int32(t.Version)
+		row.UpdatedAt = t.UpdatedAt.Format(time.RFC3339)
+	}
+	return row
+}
+
+func (h *GrpcHandler) CreateGoal(ctx context.Context, req *pb.CreateGoalRequest) (*pb.GoalResponse, error) {
+	g, err := h.service.CreateGoal(ctx, req.UserId, req.Name, req.TargetAmount, req.Deadline)
+	if err != nil {
+		return &pb.GoalResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.GoalResponse{Success: true, Message: "Goal Created", GoalId: g.ID}, nil
+}
+
+func (h *GrpcHandler) Contribute(ctx context.Context, req *pb.ContributionRequest) (*pb.TransactionResponse, error) {
+	occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, req.OccurredAt)
+	if err != nil {
+		return &pb.TransactionResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	res := h.service.Contribute(ctx, req.UserId, req.GoalId, req.Amount, req.Description, occurredAt)
+	return &pb.TransactionResponse{Success: res.Success, Message: res.Message, TransactionId: res.TransactionID}, nil
+}
+
+func (h *GrpcHandler) GetGoals(ctx context.Context, req *pb.GetGoalsRequest) (*pb.GoalList, error) {
+	list, err := h.service.GetGoals(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GoalList{}
+	for _, p := range list {
+		g := &pb.GoalProgress{
+			GoalId:          p.Goal.ID,
+			Name:            p.Goal.Name,
+			TargetAmount:    p.Goal.TargetAmount,
+			Deadline:        p.Goal.Deadline.Format("2006-01-02"),
+			Saved:           p.Saved,
+			Remaining:       p.Remaining,
+			Percent:         p.Percent,
+			MonthsLeft:      int32(p.MonthsLeft),
+			RequiredMonthly: p.RequiredMonthly,
+			AverageMonthly:  p.AverageMonthly,
+			OnTrack:         p.OnTrack,
+			Completed:       p.Completed,
+		}
+		if !p.ProjectedCompletion.IsZero() {
+			g.ProjectedCompletion = p.ProjectedCompletion.Format("2006-01-02")
+		}
+		resp.Goals = append(resp.Goals, g)
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) UploadAttachment(ctx context.Context, req *pb.UploadAttachmentRequest) (*pb.AttachmentResponse, error) {
+	a, err := h.service.AddAttachment(ctx, req.UserId, req.TransactionId, req.Filename, req.Data)
+	if err != nil {
+		return &pb.AttachmentResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.AttachmentResponse{Success: true, Message: "Uploaded", Attachment: toPbAttachment(a)}, nil
+}
+
+func (h *GrpcHandler) GetAttachment(ctx context.Context, req *pb.GetAttachmentRequest) (*pb.AttachmentData, error) {
+	a, data, err := h.service.GetAttachment(ctx, req.UserId, req.Id)
+	if errors.Is(err, service.ErrAttachmentNotFound) {
+		return &pb.AttachmentData{Success: false, Message: err.Error()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AttachmentData{Success: true, Attachment: toPbAttachment(a), Data: data}, nil
+}
+
+func (h *GrpcHandler) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.AttachmentList, error) {
+	list, err := h.service.ListAttachments(ctx, req.UserId, req.TransactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.AttachmentList{}
+	for _, a := range list {
+		resp.Attachments = append(resp.Attachments, toPbAttachment(a))
+	}
+	return resp, nil
+}
+
+func toPbAttachment(a *domain.Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:            a.ID,
+		TransactionId: a.TransactionID,
+		Filename:      a.Filename,
+		ContentType:   a.ContentType,
+		Size:          a.Size,
+		CreatedAt:     a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (h *GrpcHandler) CreateFromReceipt(ctx context.Context, req *pb.ReceiptRequest) (*pb.TransactionResponse, error) {
+	res := h.service.CreateFromReceipt(ctx, req.UserId, req.Qr, req.Category, req.Description)
+	return &pb.TransactionResponse{
+		Success:        res.Success,
+		Message:        res.Message,
+		TransactionId:  res.TransactionID,
+		AnomalyScore:   res.AnomalyScore,
+		AnomalyReasons: res.AnomalyReasons,
+	}, nil
+}
+
+func (h *GrpcHandler) QuickAdd(ctx context.Context, req *pb.QuickAddRequest) (*pb.QuickAddResponse, error) {
+	t, res := h.service.QuickAdd(ctx, req.UserId, req.Text, req.IdempotencyKey)
+	resp := &pb.QuickAddResponse{
+		Success:        res.Success,
+		Message:        res.Message,
+		TransactionId:  res.TransactionID,
+		AnomalyScore:   res.AnomalyScore,
+		AnomalyReasons: res.AnomalyReasons,
+	}
+	if t != nil {
+		resp.Amount = t.Amount
+		resp.Category = t.Category
+		resp.Description = t.Description
+		resp.OccurredAt = t.OccurredAt.Format(time.RFC3339)
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	list, err := h.service.Search(ctx, req.UserId, req.Query, req.Category, req.From, req.To, int(req.Limit), int(req.Offset))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SearchResponse{}
+	for _, r := range list {
+		resp.Hits = append(resp.Hits, &pb.SearchHit{
+			TransactionId: r.Transaction.ID,
+			Amount:        r.Transaction.Amount,
+			Category:      r.Transaction.Category,
+			Description:   r.Transaction.Description,
+			OccurredAt:    r.Transaction.OccurredAt.Format(time.RFC3339),
+			Kind:          r.Transaction.Kind,
+			Rank:          r.Rank,
+			Highlight:     r.Highlight,
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) CreateMerchant(ctx context.Context, req *pb.CreateMerchantRequest) (*pb.MerchantResponse, error) {
+	m, err := h.service.CreateMerchant(ctx, req.UserId, req.Name, req.Aliases)
+	if err != nil {
+		return &pb.MerchantResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.MerchantResponse{Success: true, Message: "Merchant Created", MerchantId: m.ID}, nil
+}
+
+func (h *GrpcHandler) AddMerchantAlias(ctx context.Context, req *pb.MerchantAliasRequest) (*pb.MerchantResponse, error) {
+	if err := h.service.AddMerchantAlias(ctx, req.UserId, req.MerchantId, req.Pattern); err != nil {
+		return &pb.MerchantResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.MerchantResponse{Success: true, Message: "Alias Added", MerchantId: req.MerchantId}, nil
+}
+
+func (h *GrpcHandler) ListMerchants(ctx context.Context, req *pb.ListMerchantsRequest) (*pb.MerchantList, error) {
+	list, err := h.service.ListMerchants(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.MerchantList{}
+	for _, m := range list {
+		resp.Merchants = append(resp.Merchants, &pb.Merchant{Id: m.ID, Name: m.Name, Aliases: m.Aliases})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetTopMerchants(ctx context.Context, req *pb.TopMerchantsRequest) (*pb.TopMerchantsResponse, error) {
+	report, err := h.service.GetTopMerchants(ctx, req.UserId, req.From, req.To, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.TopMerchantsResponse{
+		From: report.From.Format("2006-01-02"),
+		To:   report.To.Format("2006-01-02"),
+	}
+	for _, m := range report.Merchants {
+		resp.Merchants = append(resp.Merchants, &pb.MerchantStats{
+			MerchantId: m.MerchantID,
+			Name:       m.Name,
+			Total:      m.Total,
+			Visits:     int32(m.Visits),
+			Average:    m.Average,
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.SubscriptionList, error) {
+	list, err := h.service.ListSubscriptions(ctx, req.UserId, req.Refresh)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SubscriptionList{}
+	for _, s := range list {
+		sub := &pb.Subscription{
+			Name:           s.Name,
+			Category:       s.Category,
+			Cadence:        s.Cadence,
+			Amount:         s.Amount,
+			Occurrences:    int32(s.Occurrences),
+			LastCharge:     s.LastCharge.Format("2006-01-02"),
+			NextCharge:     s.NextCharge.Format("2006-01-02"),
+			YearlyCost:     s.YearlyCost,
+			PreviousAmount: s.PreviousAmount,
+		}
+		if !s.PriceChangedAt.IsZero() {
+			sub.PriceChangedAt = s.PriceChangedAt.Format("2006-01-02")
+		}
+		resp.Subscriptions = append(resp.Subscriptions, sub)
+		resp.TotalYearlyCost += s.YearlyCost
+	}
+	resp.TotalYearlyCost = math.Round(resp.TotalYearlyCost*100) / 100
+	return resp, nil
+}
+
+func (h *GrpcHandler) CreateDebt(ctx context.Context, req *pb.CreateDebtRequest) (*pb.DebtResponse, error) {
+	d := &domain.Debt{
+		UserID:       req.UserId,
+		Counterparty: req.Counterparty,
+		Direction:    req.Direction,
+		Principal:    req.Principal,
+		AnnualRate:   req.AnnualRate,
+		TermMonths:   int(req.TermMonths),
+		Payment:      req.Payment,
+	}
+	d, err := h.service.CreateDebt(ctx, d, req.StartDate)
+	if err != nil {
+		return &pb.DebtResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.DebtResponse{Success: true, Message: "Debt Created", DebtId: d.ID}, nil
+}
+
+func (h *GrpcHandler) RecordDebtPayment(ctx context.Context, req *pb.DebtPaymentRequest) (*pb.TransactionResponse, error) {
+	occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, req.OccurredAt)
+	if err != nil {
+		return &pb.TransactionResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	res := h.service.RecordDebtPayment(ctx, req.UserId, req.DebtId, req.Amount, req.Description, occurredAt)
+	return &pb.TransactionResponse{Success: res.Success, Message: res.Message, TransactionId: res.TransactionID}, nil
+}
+
+func (h *GrpcHandler) ListDebts(ctx context.Context, req *pb.ListDebtsRequest) (*pb.DebtList, error) {
+	list, err := h.service.ListDebts(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.DebtList{}
+	for _, st := range list {
+		resp.Debts = append(resp.Debts, toPbDebt(st))
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) GetDebtSchedule(ctx context.Context, req *pb.DebtScheduleRequest) (*pb.DebtSchedule, error) {
+	st, err := h.service.GetDebtSchedule(ctx, req.UserId, req.DebtId, req.ExtraPayments)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.DebtSchedule{Debt: toPbDebt(st)}
+	for _, r := range st.Schedule {
+		resp.Schedule = append(resp.Schedule, &pb.AmortisationRow{
+			N:         int32(r.N),
+			Date:      r.Date.Format("2006-01-02"),
+			Payment:   r.Payment,
+			Interest:  r.Interest,
+			Principal: r.Principal,
+			Balance:   r.Balance,
+		})
+	}
+	for _, p := range st.Projections {
+		proj := &pb.PayoffProjection{
+			ExtraPayment:  p.ExtraPayment,
+			PaysOff:       p.PaysOff,
+			Months:        int32(p.Months),
+			TotalInterest: p.TotalInterest,
+			MonthsSaved:   int32(p.MonthsSaved),
+			InterestSaved: p.InterestSaved,
+		}
+		if !p.PayoffDate.IsZero() {
+			proj.PayoffDate = p.PayoffDate.Format("2006-01-02")
+		}
+		resp.Projections = append(resp.Projections, proj)
+	}
+	return resp, nil
+}
+
+func toPbDebt(st *domain.DebtStatus) *pb.Debt {
+	return &pb.Debt{
+		DebtId:          st.Debt.ID,
+		Counterparty:    st.Debt.Counterparty,
+		Direction:       st.Debt.Direction,
+		Principal:       st.Debt.Principal,
+		AnnualRate:      st.Debt.AnnualRate,
+		StartDate:       st.Debt.StartDate.Format("2006-01-02"),
+		TermMonths:      int32(st.Debt.TermMonths),
+		Payment:         st.Payment,
+		PrincipalPaid:   st.PrincipalPaid,
+		InterestPaid:    st.InterestPaid,
+		Balance:         st.Balance,
+		AccruedInterest: st.AccruedInterest,
+		Outstanding:     st.Outstanding,
+	}
+}
+
+func (h *GrpcHandler) RecordIncome(ctx context.Context, req *pb.IncomeRequest) (*pb.TransactionResponse, error) {
+	occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, req.OccurredAt)
+	if err != nil {
+		return &pb.TransactionResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	res := h.service.RecordIncome(ctx, req.UserId, req.Amount, req.Category, req.Description, occurredAt)
+	return &pb.TransactionResponse{Success: res.Success, Message: res.Message, TransactionId: res.TransactionID}, nil
+}
+
+func (h *GrpcHandler) MoveEnvelope(ctx context.Context, req *pb.EnvelopeMoveRequest) (*pb.EnvelopeResponse, error) {
+	m := &domain.EnvelopeMove{
+		UserID:       req.UserId,
+		FromCategory: req.FromCategory,
+		ToCategory:   req.ToCategory,
+		Amount:       req.Amount,
+		Note:         req.Note,
+	}
+	if err := h.service.MoveEnvelope(ctx, m); err != nil {
+		return &pb.EnvelopeResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.EnvelopeResponse{Success: true, Message: "Moved", MoveId: m.ID}, nil
+}
+
+func (h *GrpcHandler) GetEnvelopes(ctx context.Context, req *pb.GetEnvelopesRequest) (*pb.EnvelopeSummary, error) {
+	summary, err := h.service.GetEnvelopes(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.EnvelopeSummary{
+		Since:      summary.Since.Format(time.RFC3339),
+		Income:     summary.Income,
+		Unassigned: summary.Unassigned,
+	}
+	for _, e := range summary.Envelopes {
+		resp.Envelopes = append(resp.Envelopes, &pb.Envelope{
+			Category:  e.Category,
+			Allocated: e.Allocated,
+			Spent:     e.Spent,
+			Balance:   e.Balance,
+		})
+	}
+	for _, m := range summary.Moves {
+		resp.Moves = append(resp.Moves, &pb.EnvelopeMove{
+			Id:           m.ID,
+			FromCategory: m.FromCategory,
+			ToCategory:   m.ToCategory,
+			Amount:       m.Amount,
+			Note:         m.Note,
+			CreatedAt:    m.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (h *GrpcHandler) CreateExpenseReport(ctx context.Context, req *pb.CreateExpenseReportRequest) (*pb.ExpenseReportResponse, error) {
+	rep, err := h.service.CreateExpenseReport(ctx, req.UserId, req.Title, req.TransactionIds)
+	if err != nil {
+		return &pb.ExpenseReportResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.ExpenseReportResponse{Success: true, Message: "Report Created", Report: toPbExpenseReport(rep)}, nil
+}
+
+func (h *GrpcHandler) SetExpenseReportStatus(ctx context.Context, req *pb.ExpenseReportStatusRequest) (*pb.ExpenseReportResponse, error) {
+	if err := h.service.SetExpenseReportStatus(ctx, req.UserId, req.ReportId, req.Status); err != nil {
+		return &pb.ExpenseReportResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.ExpenseReportResponse{Success: true, Message: "Status Updated"}, nil
+}
+
+func (h *GrpcHandler) RemoveExpenseReportItem(ctx context.Context, req *pb.ExpenseReportItemRequest) (*pb.ExpenseReportResponse, error) {
+	rep, err := h.service.RemoveExpenseReportItem(ctx, req.UserId, req.ReportId, req.TransactionId)
+	if err != nil {
+		return &pb.ExpenseReportResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.ExpenseReportResponse{Success: true, Message: "Item Removed", Report: toPbExpenseReport(rep)}, nil
+}
+
+func (h *GrpcHandler) ReimburseExpenseReport(ctx context.Context, req *pb.ReimburseRequest) (*pb.TransactionResponse, error) {
+	occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, req.OccurredAt)
+	if err != nil {
+		return &pb.TransactionResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	res := h.service.ReimburseExpenseReport(ctx, req.UserId, req.ReportId, req.Amount, occurredAt)
+	return &pb.TransactionResponse{Success: res.Success, Message: res.Message, TransactionId: res.TransactionID}, nil
+}
+
+func (h *GrpcHandler) ListExpenseReports(ctx context.Context, req *pb.ListExpenseReportsRequest) (*pb.ExpenseReportList, error) {
+	list, err := h.service.ListExpenseReports(ctx, req.UserId, req.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ExpenseReportList{}
+	for _, rep := range list {
+		resp.Reports = append(resp.Reports, toPbExpenseReport(rep))
+	}
+	return resp, nil
+}
+
+func toPbExpenseReport(rep *domain.ExpenseReport) *pb.ExpenseReport {
+	r := &pb.ExpenseReport{
+		ReportId:         rep.ID,
+		Title:            rep.Title,
+		Status:           rep.Status,
+		Total:            rep.Total,
+		ReimbursedAmount: rep.ReimbursedAmount,
+		ReimbursementId:  rep.ReimbursementID,
+		CreatedAt:        rep.CreatedAt.Format(time.RFC3339),
+	}
+	if !rep.SubmittedAt.IsZero() {
+		r.SubmittedAt = rep.SubmittedAt.Format(time.RFC3339)
+	}
+	if !rep.ApprovedAt.IsZero() {
+		r.ApprovedAt = rep.ApprovedAt.Format(time.RFC3339)
+	}
+	if !rep.PaidAt.IsZero() {
+		r.PaidAt = rep.PaidAt.Format(time.RFC3339)
+	}
+	for _, t := range rep.Items {
+		r.Items = append(r.Items, &pb.ExpenseItem{
+			TransactionId: t.ID,
+			Amount:        t.Amount,
+			Category:      t.Category,
+			Description:   t.Description,
+			OccurredAt:    t.OccurredAt.Format(time.RFC3339),
+		})
+	}
+	return r
+}
+
+func (h *GrpcHandler) RecordRefund(ctx context.Context, req *pb.RefundRequest) (*pb.TransactionResponse, error) {
+	occurredAt, err := h.service.ParseOccurredAt(ctx, req.UserId, req.OccurredAt)
+	if err != nil {
+		return &pb.TransactionResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	res := h.service.RecordRefund(ctx, req.UserId, req.TransactionId, req.Amount, req.Description, occurredAt)
+	return &pb.TransactionResponse{Success: res.Success, Message: res.Message, TransactionId: res.TransactionID}, nil
+}
+
+func (h *GrpcHandler) SetRefundAttribution(ctx context.Context, req *pb.SetRefundAttributionRequest) (*pb.SettingsResponse, error) {
+	if err := h.service.SetRefundAttribution(ctx, req.UserId, req.RefundAttribution); err != nil {
+		return &pb.SettingsResponse{Success: false, Message: err.Error()}, nil
+	}
+	return &pb.SettingsResponse{Success: true, Message: "Refund Attribution Set"}, nil
+}
+
+func (h *GrpcHandler) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.TransactionList, error) {
+	list, err := h.service.ListTransactions(ctx, req.UserId, req.From, req.To, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.TransactionList{}
+	for _, t := range list {
+		e := &pb.LedgerEntry{
+			TransactionId:   t.ID,
+			Amount:          t.Amount,
+			Category:        t.Category,
+			Description:     t.Description,
+			OccurredAt:      t.OccurredAt.Format(time.RFC3339),
+			Kind:            t.Kind,
+			Tags:            t.Tags,
+			RefundOf:        t.RefundOf,
+			RefundedAmount:  t.RefundedAmount,
+			Reimbursable:    t.Reimbursable,
+			Reimbursed:      t.Reimbursed,
+			ExpenseReportId: t.ExpenseReportID,
+		}
+		if !t.AppliesAt.IsZero() {
+			e.AppliesAt = t.AppliesAt.Format(time.RFC3339)
+		}
+		resp.Transactions = append(resp.Transactions, e)
+	}
+	return resp, nil
+}
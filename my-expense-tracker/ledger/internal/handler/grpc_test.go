@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/repository"
+	"github.com/yuramishin/expense-tracker/ledger/internal/service"
+	pb "github.com/yuramishin/expense-tracker/proto/pb_ledger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// batchStream feeds the given number of rows to CreateTransactions and
+// records how many were read.
+type batchStream struct {
+	grpc.ServerStream
+	rows int
+	read int
+}
+
+func (s *batchStream) Context() context.Context { return context.Background() }
+
+func (s *batchStream) Recv() (*pb.BatchTransactionItem, error) {
+	if s.read == s.rows {
+		return nil, io.EOF
+	}
+	s.read++
+	return &pb.BatchTransactionItem{
+		Row:         int32(s.read),
+		Transaction: &pb.TransactionRequest{UserId: 1, Amount: 100, Category: "Еда"},
+	}, nil
+}
+
+func (s *batchStream) SendAndClose(*pb.BatchTransactionResponse) error {
+	return nil
+}
+
+func TestCreateTransactionsRowLimit(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	// The database is unreachable: rows fall back to the default timezone
+	// and the limit must be enforced before anything is saved.
+	db, err := sql.Open("postgres", "host=/nonexistent dbname=ledger sslmode=disable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	h := NewGrpcHandler(service.NewLedgerService(repository.NewPostgresRepo(db), nil, nil, time.UTC))
+
+	stream := &batchStream{rows: service.BatchMaxRows + 5}
+	err = h.CreateTransactions(stream)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("CreateTransactions() error = %v, want InvalidArgument", err)
+	}
+	if stream.read != service.BatchMaxRows+1 {
+		t.Errorf("read %d rows, want to stop at row %d", stream.read, service.BatchMaxRows+1)
+	}
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+var ErrAnomalyNotFound = errors.New("anomaly not found")
+
+func (r *PostgresRepo) GetCategoryAmounts(userID int64, category string, since time.Time) ([]float64, error) {
+	rows, err := r.db.Query("SELECT amount FROM transactions WHERE user_id = $1 AND category = $2 AND occurred_at >= $3"+expenseOnly, userID, category, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []float64
+	for rows.Next() {
+		var a float64
+		if err := rows.Scan(&a); err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+func (r *PostgresRepo) CountTransactions(userID int64) (int, error) {
+	var n int
+	err := r.db.QueryRow("SELECT COUNT(*) FROM transactions WHERE user_id = $1"+expenseOnly, userID).Scan(&n)
+	return n, err
+}
+
+// ListExpenseMerchants returns the distinct descriptions of the user's
+// expenses with the merchant each is linked to.
+func (r *PostgresRepo) ListExpenseMerchants(userID int64) ([]*domain.Transaction, error) {
+	rows, err := r.db.Query("SELECT DISTINCT description, COALESCE(merchant_id, 0) FROM transactions WHERE user_id = $1 AND description <> ''"+expenseOnly, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Transaction
+	for rows.Next() {
+		t := &domain.Transaction{UserID: userID}
+		if err := rows.Scan(&t.Description, &t.MerchantID); err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
+
+func (r *PostgresRepo) HasSimilarTransaction(t *domain.Transaction, since time.Time) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(`
+		SELECT EXISTS (SELECT 1 FROM transactions
+		WHERE user_id = $1 AND amount = $2 AND category = $3 AND LOWER(TRIM(description)) = $4 AND created_at >= $5`+expenseOnly+`)`,
+		t.UserID, t.Amount, t.Category, strings.ToLower(strings.TrimSpace(t.Description)), since).Scan(&exists)
+	return exists, err
+}
+
+func (r *PostgresRepo) CreateAnomaly(a *domain.Anomaly) error {
+	return r.db.QueryRow("INSERT INTO anomalies (user_id, transaction_id, score, reasons) VALUES ($1, $2, $3, $4) RETURNING id",
+		a.UserID, a.TransactionID, a.Score, pq.Array(a.Reasons)).Scan(&a.ID)
+}
+
+func (r *PostgresRepo) ListAnomalies(userID int64, includeReviewed bool) ([]*domain.Anomaly, error) {
+	rows, err := r.db.Query(`
+		SELECT a.id, a.transaction_id, t.amount, t.category, t.description, a.score, a.reasons, a.reviewed, a.created_at
+		FROM anomalies a JOIN transactions t ON t.id = a.transaction_id
+		WHERE a.user_id = $1 AND ($2 OR NOT a.reviewed)
+		ORDER BY a.created_at DESC`, userID, includeReviewed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Anomaly
+	for rows.Next() {
+		a := &domain.Anomaly{UserID: userID}
+		if err := rows.Scan(&a.ID, &a.TransactionID, &a.Amount, &a.Category, &a.Description,
+			&a.Score, pq.Array(&a.Reasons), &a.Reviewed, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+func (r *PostgresRepo) ReviewAnomaly(userID, id int64) error {
+	res, err := r.db.Exec("UPDATE anomalies SET reviewed = TRUE WHERE id = $1 AND user_id = $2", id, userID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrAnomalyNotFound
+	}
+	return nil
+}
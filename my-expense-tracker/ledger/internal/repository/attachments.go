@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func (r *PostgresRepo) CreateAttachment(a *domain.Attachment) error {
+	return r.db.QueryRow(`
+		INSERT INTO attachments (user_id, transaction_id, filename, content_type, size, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at`,
+		a.UserID, a.TransactionID, a.Filename, a.ContentType, a.Size, a.StorageKey).Scan(&a.ID, &a.CreatedAt)
+}
+
+// GetAttachment returns the user's attachment by id, or nil if it does not
+// exist or belongs to someone else.
+func (r *PostgresRepo) GetAttachment(userID, id int64) (*domain.Attachment, error) {
+	a := &domain.Attachment{UserID: userID}
+	err := r.db.QueryRow(`
+		SELECT id, transaction_id, filename, content_type, size, storage_key, created_at
+		FROM attachments WHERE user_id = $1 AND id = $2`, userID, id).
+		Scan(&a.ID, &a.TransactionID, &a.Filename, &a.ContentType, &a.Size, &a.StorageKey, &a.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (r *PostgresRepo) ListAttachments(userID, transactionID int64) ([]*domain.Attachment, error) {
+	rows, err := r.db.Query(`
+		SELECT id, transaction_id, filename, content_type, size, storage_key, created_at
+		FROM attachments WHERE user_id = $1 AND transaction_id = $2
+		ORDER BY id`, userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Attachment
+	for rows.Next() {
+		a := &domain.Attachment{UserID: userID}
+		if err := rows.Scan(&a.ID, &a.TransactionID, &a.Filename, &a.ContentType, &a.Size, &a.StorageKey, &a.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
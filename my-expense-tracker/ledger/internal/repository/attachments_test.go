@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// TestAttachmentsOfOtherUsers needs a database migrated by the ledger in
+// TEST_DATABASE_URL.
+func TestAttachmentsOfOtherUsers(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	repo := NewPostgresRepo(db)
+
+	owner := time.Now().UnixNano() % 1_000_000_000
+	other := owner + 1
+	defer db.Exec("DELETE FROM transactions WHERE user_id = $1", owner)
+
+	tr := &domain.Transaction{UserID: owner, Amount: 350, Category: "Кафе", Kind: domain.KindExpense, OccurredAt: time.Now()}
+	if err := repo.CreateTransaction(tr); err != nil {
+		t.Fatal(err)
+	}
+	a := &domain.Attachment{UserID: owner, TransactionID: tr.ID, Filename: "receipt.png", ContentType: "image/png", Size: 16, StorageKey: "test/receipt"}
+	if err := repo.CreateAttachment(a); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := repo.GetAttachment(other, a.ID); err != nil || got != nil {
+		t.Errorf("GetAttachment() by another user = %+v, %v, want not found", got, err)
+	}
+	if list, err := repo.ListAttachments(other, tr.ID); err != nil || len(list) != 0 {
+		t.Errorf("ListAttachments() by another user = %v, %v, want none", list, err)
+	}
+	if got, err := repo.GetAttachment(owner, a.ID); err != nil || got == nil {
+		t.Errorf("GetAttachment() by the owner = %+v, %v, want the attachment", got, err)
+	}
+
+	// Deleting the transaction takes its attachments with it.
+	ok, keys, err := repo.DeleteTransaction(owner, tr.ID, 0)
+	if err != nil || !ok || len(keys) != 1 || keys[0] != a.StorageKey {
+		t.Errorf("DeleteTransaction() = %v, %v, %v, want the attachment key", ok, keys, err)
+	}
+	if got, err := repo.GetAttachment(owner, a.ID); err != nil || got != nil {
+		t.Errorf("GetAttachment() after delete = %+v, %v, want not found", got, err)
+	}
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const selectDebt = `
+	SELECT id, counterparty, direction, principal, annual_rate, start_date, term_months, payment, created_at
+	FROM debts`
+
+func scanDebt(row interface{ Scan(...interface{}) error }, userID int64) (*domain.Debt, error) {
+	d := &domain.Debt{UserID: userID}
+	err := row.Scan(&d.ID, &d.Counterparty, &d.Direction, &d.Principal, &d.AnnualRate, &d.StartDate, &d.TermMonths, &d.Payment, &d.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (r *PostgresRepo) CreateDebt(d *domain.Debt) error {
+	return r.db.QueryRow(`
+		INSERT INTO debts (user_id, counterparty, direction, principal, annual_rate, start_date, term_months, payment)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at`,
+		d.UserID, d.Counterparty, d.Direction, d.Principal, d.AnnualRate, d.StartDate.Format("2006-01-02"), d.TermMonths, d.Payment).
+		Scan(&d.ID, &d.CreatedAt)
+}
+
+// GetDebt returns the user's debt by id, or nil if it does not exist.
+func (r *PostgresRepo) GetDebt(userID, id int64) (*domain.Debt, error) {
+	d, err := scanDebt(r.db.QueryRow(selectDebt+" WHERE user_id = $1 AND id = $2", userID, id), userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return d, err
+}
+
+func (r *PostgresRepo) ListDebts(userID int64) ([]*domain.Debt, error) {
+	rows, err := r.db.Query(selectDebt+" WHERE user_id = $1 ORDER BY start_date, id", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Debt
+	for rows.Next() {
+		d, err := scanDebt(rows, userID)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	return list, rows.Err()
+}
+
+// ListDebtPayments returns the payments recorded against a debt, oldest first.
+func (r *PostgresRepo) ListDebtPayments(userID, debtID int64) ([]*domain.Transaction, error) {
+	rows, err := r.db.Query(`
+		SELECT id, amount, category, description, occurred_at, created_at FROM transactions
+		WHERE user_id = $1 AND debt_id = $2 AND kind = 'debt_payment'
+		ORDER BY occurred_at`, userID, debtID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Transaction
+	for rows.Next() {
+		t := &domain.Transaction{UserID: userID, Kind: domain.KindDebtPayment, DebtID: debtID}
+		if err := rows.Scan(&t.ID, &t.Amount, &t.Category, &t.Description, &t.OccurredAt, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
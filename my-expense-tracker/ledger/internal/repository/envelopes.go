@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// CreateEnvelopeMove stores m after check approved it against the income,
+// allocations and spending since the given time. Moves of a user are
+// serialized on their settings row, so concurrent moves cannot spend the
+// same money twice.
+func (r *PostgresRepo) CreateEnvelopeMove(m *domain.EnvelopeMove, since time.Time, check func(income float64, allocated, spent map[string]float64) error) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("SELECT 1 FROM user_settings WHERE user_id = $1 FOR UPDATE", m.UserID); err != nil {
+		return err
+	}
+	income, err := getIncome(tx, m.UserID, since)
+	if err != nil {
+		return err
+	}
+	allocated, err := getEnvelopeAllocations(tx, m.UserID, since)
+	if err != nil {
+		return err
+	}
+	spent, err := getSpentByCategory(tx, m.UserID, since)
+	if err != nil {
+		return err
+	}
+	if err := check(income, allocated, spent); err != nil {
+		return err
+	}
+
+	if err := tx.QueryRow(`
+		INSERT INTO envelope_moves (user_id, from_category, to_category, amount, note)
+		VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5)
+		RETURNING id, created_at`,
+		m.UserID, m.FromCategory, m.ToCategory, m.Amount, m.Note).Scan(&m.ID, &m.CreatedAt); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ListEnvelopeMoves returns the latest moves made since the given time.
+func (r *PostgresRepo) ListEnvelopeMoves(userID int64, since time.Time, limit int) ([]*domain.EnvelopeMove, error) {
+	rows, err := r.db.Query(`
+		SELECT id, COALESCE(from_category, ''), COALESCE(to_category, ''), amount, COALESCE(note, ''), created_at
+		FROM envelope_moves WHERE user_id = $1 AND created_at >= $2
+		ORDER BY created_at DESC, id DESC LIMIT $3`, userID, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.EnvelopeMove
+	for rows.Next() {
+		m := &domain.EnvelopeMove{UserID: userID}
+		if err := rows.Scan(&m.ID, &m.FromCategory, &m.ToCategory, &m.Amount, &m.Note, &m.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
+// GetEnvelopeAllocations returns the net amount moved into every envelope
+// since the given time.
+func (r *PostgresRepo) GetEnvelopeAllocations(userID int64, since time.Time) (map[string]float64, error) {
+	return getEnvelopeAllocations(r.db, userID, since)
+}
+
+func getEnvelopeAllocations(q querier, userID int64, since time.Time) (map[string]float64, error) {
+	rows, err := q.Query(`
+		SELECT category, SUM(amount) FROM (
+			SELECT to_category AS category, amount FROM envelope_moves
+			WHERE user_id = $1 AND created_at >= $2 AND to_category IS NOT NULL
+			UNION ALL
+			SELECT from_category, -amount FROM envelope_moves
+			WHERE user_id = $1 AND created_at >= $2 AND from_category IS NOT NULL
+		) m GROUP BY category`, userID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	allocated := make(map[string]float64)
+	for rows.Next() {
+		var cat string
+		var sum float64
+		if err := rows.Scan(&cat, &sum); err != nil {
+			return nil, err
+		}
+		allocated[cat] = sum
+	}
+	return allocated, rows.Err()
+}
+
+// GetSpentByCategory returns spending per category since the given time.
+func (r *PostgresRepo) GetSpentByCategory(userID int64, since time.Time) (map[string]float64, error) {
+	return getSpentByCategory(r.db, userID, since)
+}
+
+func getSpentByCategory(q querier, userID int64, since time.Time) (map[string]float64, error) {
+	rows, err := q.Query("SELECT category, SUM(amount) FROM "+spending+" WHERE user_id = $1 AND occurred_at >= $2 GROUP BY category", userID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	spent := make(map[string]float64)
+	for rows.Next() {
+		var cat string
+		var sum float64
+		if err := rows.Scan(&cat, &sum); err != nil {
+			return nil, err
+		}
+		spent[cat] = sum
+	}
+	return spent, rows.Err()
+}
+
+// GetIncome returns the income that occurred since the given time. Like
+// spending, income counts by when it occurred rather than when it was
+// recorded, so income dated before envelopes were switched on does not fund
+// them even when it is recorded later.
+func (r *PostgresRepo) GetIncome(userID int64, since time.Time) (float64, error) {
+	return getIncome(r.db, userID, since)
+}
+
+func getIncome(q querier, userID int64, since time.Time) (float64, error) {
+	var sum float64
+	err := q.QueryRow("SELECT COALESCE(SUM(amount), 0) FROM transactions WHERE user_id = $1 AND occurred_at >= $2 AND kind = 'income'",
+		userID, since).Scan(&sum)
+	return sum, err
+}
+
+// querier runs queries on the database or within a transaction.
+type querier interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func (r *PostgresRepo) CreateGoal(g *domain.Goal) error {
+	return r.db.QueryRow(`
+		INSERT INTO goals (user_id, name, target_amount, deadline) VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at`,
+		g.UserID, g.Name, g.TargetAmount, g.Deadline.Format("2006-01-02")).Scan(&g.ID, &g.CreatedAt)
+}
+
+// GetGoal returns the user's goal by id, or nil if it does not exist.
+func (r *PostgresRepo) GetGoal(userID, id int64) (*domain.Goal, error) {
+	g := &domain.Goal{UserID: userID}
+	err := r.db.QueryRow("SELECT id, name, target_amount, deadline, created_at FROM goals WHERE user_id = $1 AND id = $2", userID, id).
+		Scan(&g.ID, &g.Name, &g.TargetAmount, &g.Deadline, &g.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (r *PostgresRepo) ListGoals(userID int64) ([]*domain.Goal, error) {
+	rows, err := r.db.Query("SELECT id, name, target_amount, deadline, created_at FROM goals WHERE user_id = $1 ORDER BY deadline, id", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Goal
+	for rows.Next() {
+		g := &domain.Goal{UserID: userID}
+		if err := rows.Scan(&g.ID, &g.Name, &g.TargetAmount, &g.Deadline, &g.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, g)
+	}
+	return list, rows.Err()
+}
+
+// ListContributions returns the savings entries recorded for a goal, oldest first.
+func (r *PostgresRepo) ListContributions(userID, goalID int64) ([]*domain.Transaction, error) {
+	rows, err := r.db.Query(`
+		SELECT id, amount, category, description, occurred_at, created_at FROM transactions
+		WHERE user_id = $1 AND goal_id = $2 AND kind = 'saving'
+		ORDER BY occurred_at`, userID, goalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Transaction
+	for rows.Next() {
+		t := &domain.Transaction{UserID: userID, Kind: domain.KindSaving, GoalID: goalID}
+		if err := rows.Scan(&t.ID, &t.Amount, &t.Category, &t.Description, &t.OccurredAt, &t.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,50 @@
+package repository
+
+import "github.com/yuramishin/expense-tracker/ledger/internal/domain"
+
+// SetCategoryGroup replaces the categories of the user's group; an empty list
+// removes the group. The periods of the group's budget are reopened, as its
+// spending in every month changes with the categories.
+func (r *PostgresRepo) SetCategoryGroup(userID int64, g *domain.CategoryGroup) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("DELETE FROM category_groups WHERE user_id = $1 AND name = $2", userID, g.Name); err != nil {
+		return err
+	}
+	for _, cat := range g.Categories {
+		if _, err := tx.Exec("INSERT INTO category_groups (user_id, name, category) VALUES ($1, $2, $3)", userID, g.Name, cat); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.Exec("UPDATE budget_periods SET closed = FALSE WHERE user_id = $1 AND category = $2",
+		userID, domain.ScopeGroupPrefix+g.Name); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresRepo) ListCategoryGroups(userID int64) ([]*domain.CategoryGroup, error) {
+	rows, err := r.db.Query("SELECT name, category FROM category_groups WHERE user_id = $1 ORDER BY name, category", userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.CategoryGroup
+	for rows.Next() {
+		var name, cat string
+		if err := rows.Scan(&name, &cat); err != nil {
+			return nil, err
+		}
+		if len(list) == 0 || list[len(list)-1].Name != name {
+			list = append(list, &domain.CategoryGroup{Name: name})
+		}
+		last := list[len(list)-1]
+		last.Categories = append(last.Categories, cat)
+	}
+	return list, rows.Err()
+}
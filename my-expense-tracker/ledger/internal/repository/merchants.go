@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+var ErrMerchantNotFound = errors.New("merchant not found")
+
+// CreateMerchant stores m with the user's alias patterns, or adds them to
+// the user's merchant with the same name if there already is one. Existing
+// transactions are linked to it in the same database transaction, see
+// linkMerchant.
+func (r *PostgresRepo) CreateMerchant(m *domain.Merchant, patterns []string, match MerchantMatcher) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := tx.QueryRow(`
+		INSERT INTO merchants (user_id, name) VALUES ($1, $2)
+		ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id`, m.UserID, m.Name).Scan(&m.ID); err != nil {
+		return err
+	}
+	for _, p := range patterns {
+		if _, err := tx.Exec(`
+			INSERT INTO merchant_aliases (merchant_id, pattern) VALUES ($1, $2)
+			ON CONFLICT (merchant_id, pattern) DO NOTHING`, m.ID, p); err != nil {
+			return err
+		}
+	}
+	if err := linkMerchant(tx, m.UserID, m.ID, match); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// AddMerchantAlias adds a pattern to the user's merchant and links existing
+// transactions to it in one database transaction, see linkMerchant.
+func (r *PostgresRepo) AddMerchantAlias(userID, merchantID int64, pattern string, match MerchantMatcher) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var exists bool
+	if err := tx.QueryRow("SELECT EXISTS (SELECT 1 FROM merchants WHERE user_id = $1 AND id = $2)", userID, merchantID).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return ErrMerchantNotFound
+	}
+	if _, err := tx.Exec(`
+		INSERT INTO merchant_aliases (merchant_id, pattern) VALUES ($1, $2)
+		ON CONFLICT (merchant_id, pattern) DO NOTHING`, merchantID, pattern); err != nil {
+		return err
+	}
+	if err := linkMerchant(tx, userID, merchantID, match); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// MerchantMatcher returns the merchant a transaction description belongs to
+// among the user's aliases, or 0 if none.
+type MerchantMatcher func(description string, aliases []*domain.MerchantAlias) int64
+
+// linkMerchant links to the merchant the user's transactions it matches best
+// among all of the user's aliases, the way new transactions are matched, so a
+// transaction another merchant's alias matches better keeps its link. Refunds
+// follow the expense they refund.
+func linkMerchant(tx *sql.Tx, userID, merchantID int64, match MerchantMatcher) error {
+	aliases, err := listMerchantAliases(tx, userID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(`
+		SELECT id, description FROM transactions
+		WHERE user_id = $1 AND refund_of IS NULL AND description <> '' AND merchant_id IS DISTINCT FROM $2`,
+		userID, merchantID)
+	if err != nil {
+		return err
+	}
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		var description string
+		if err := rows.Scan(&id, &description); err != nil {
+			rows.Close()
+			return err
+		}
+		if match(description, aliases) == merchantID {
+			ids = append(ids, id)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(`
+		UPDATE transactions SET merchant_id = $2
+		WHERE user_id = $1 AND (id = ANY($3) OR refund_of = ANY($3))`,
+		userID, merchantID, pq.Array(ids))
+	return err
+}
+
+// ListMerchantAliases returns the aliases of all the user's merchants in the
+// order they were added.
+func (r *PostgresRepo) ListMerchantAliases(userID int64) ([]*domain.MerchantAlias, error) {
+	return listMerchantAliases(r.db, userID)
+}
+
+func listMerchantAliases(q querier, userID int64) ([]*domain.MerchantAlias, error) {
+	rows, err := q.Query(`
+		SELECT a.merchant_id, a.pattern FROM merchant_aliases a
+		JOIN merchants m ON m.id = a.merchant_id
+		WHERE m.user_id = $1
+		ORDER BY a.id`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.MerchantAlias
+	for rows.Next() {
+		a := &domain.MerchantAlias{}
+		if err := rows.Scan(&a.MerchantID, &a.Pattern); err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+func (r *PostgresRepo) ListMerchants(userID int64) ([]*domain.Merchant, error) {
+	rows, err := r.db.Query(`
+		SELECT m.id, m.name, COALESCE(array_agg(a.pattern ORDER BY a.pattern) FILTER (WHERE a.pattern IS NOT NULL), '{}')
+		FROM merchants m LEFT JOIN merchant_aliases a ON a.merchant_id = m.id
+		WHERE m.user_id = $1
+		GROUP BY m.id, m.name ORDER BY m.name`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Merchant
+	for rows.Next() {
+		m := &domain.Merchant{UserID: userID}
+		if err := rows.Scan(&m.ID, &m.Name, pq.Array(&m.Aliases)); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
+func (r *PostgresRepo) GetMerchantStats(userID int64, from, to time.Time, limit int) ([]*domain.MerchantStats, error) {
+	rows, err := r.db.Query(`
+		SELECT m.id, m.name, SUM(s.amount), COUNT(*) FILTER (WHERE s.amount > 0),
+			SUM(s.amount) / NULLIF(COUNT(*) FILTER (WHERE s.amount > 0), 0)
+		FROM `+spending+` JOIN merchants m ON m.id = s.merchant_id
+		WHERE s.user_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+		GROUP BY m.id, m.name
+		HAVING SUM(s.amount) > 0
+		ORDER BY SUM(s.amount) DESC, m.name
+		LIMIT $4`, userID, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.MerchantStats
+	for rows.Next() {
+		m := &domain.MerchantStats{}
+		if err := rows.Scan(&m.MerchantID, &m.Name, &m.Total, &m.Visits, &m.Average); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
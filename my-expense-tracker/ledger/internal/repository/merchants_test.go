@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"database/sql"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// containsMatcher matches the longest alias contained in the lowercased
+// description, standing in for the service's word matching.
+func containsMatcher(description string, aliases []*domain.MerchantAlias) int64 {
+	description = strings.ToLower(strings.ReplaceAll(description, "*", " "))
+	var best *domain.MerchantAlias
+	for _, a := range aliases {
+		if strings.Contains(description, a.Pattern) && (best == nil || len(a.Pattern) > len(best.Pattern)) {
+			best = a
+		}
+	}
+	if best == nil {
+		return 0
+	}
+	return best.MerchantID
+}
+
+// TestCreateMerchantLinksExisting needs a database migrated by the ledger in
+// TEST_DATABASE_URL.
+func TestCreateMerchantLinksExisting(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	repo := NewPostgresRepo(db)
+
+	userID := time.Now().UnixNano() % 1_000_000_000
+	defer db.Exec("DELETE FROM transactions WHERE user_id = $1", userID)
+	defer db.Exec("DELETE FROM merchants WHERE user_id = $1", userID)
+
+	var ids []int64
+	for _, description := range []string{"YANDEX*TAXI 4521 MOSCOW", "Yandex Plus", "Кофейня"} {
+		tr := &domain.Transaction{UserID: userID, Amount: 300, Category: "Разное", Description: description, Kind: domain.KindExpense, OccurredAt: time.Now()}
+		if err := repo.CreateTransaction(tr); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, tr.ID)
+	}
+
+	taxi := &domain.Merchant{UserID: userID, Name: "Yandex Taxi"}
+	if err := repo.CreateMerchant(taxi, []string{"yandex taxi"}, containsMatcher); err != nil {
+		t.Fatal(err)
+	}
+	// The shorter alias must not take over the taxi ride.
+	yandex := &domain.Merchant{UserID: userID, Name: "Yandex"}
+	if err := repo.CreateMerchant(yandex, []string{"yandex"}, containsMatcher); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []int64{taxi.ID, yandex.ID, 0} {
+		tr, err := repo.GetTransaction(userID, ids[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tr.MerchantID != want {
+			t.Errorf("%q linked to merchant %d, want %d", tr.Description, tr.MerchantID, want)
+		}
+	}
+}
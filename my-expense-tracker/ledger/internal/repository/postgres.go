@@ -2,8 +2,11 @@ package repository
 
 import (
 	"database/sql"
+	"errors"
+	"strings"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
 )
 
@@ -15,37 +18,201 @@ func NewPostgresRepo(db *sql.DB) *PostgresRepo {
 	return &PostgresRepo{db: db}
 }
 
+// expenseOnly restricts spending queries to expenses, leaving out ledger
+// entries such as savings contributions and expenses that were reimbursed.
+// An expense is reimbursed once its expense report is paid, not before.
+const expenseOnly = " AND kind = 'expense' AND NOT reimbursed"
+
+// spending is the source of spending totals: expenses as in expenseOnly, and
+// refunds as negative amounts dated to the month they apply to.
+const spending = `(
+	SELECT user_id, category, tags, merchant_id, amount, occurred_at FROM transactions WHERE kind = 'expense' AND NOT reimbursed
+	UNION ALL
+	SELECT user_id, category, tags, merchant_id, -amount, applies_at FROM transactions WHERE kind = 'refund'
+) s`
+
+const insertTransaction = `
+	INSERT INTO transactions (user_id, amount, category, description, occurred_at, row_uuid, kind, goal_id, fiscal_key, merchant_id, debt_id, tags, reimbursable,
+		refund_of, applies_at)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), COALESCE(NULLIF($7, ''), 'expense'), NULLIF($8, 0), NULLIF($9, ''), NULLIF($10, 0), NULLIF($11, 0), COALESCE($12::text[], '{}'), $13,
+		NULLIF($14, 0), $15)
+	RETURNING id, created_at, updated_at, version, kind`
+
+func insertArgs(t *domain.Transaction) []interface{} {
+	return []interface{}{t.UserID, t.Amount, t.Category, t.Description, t.OccurredAt, t.RowUUID, t.Kind, t.GoalID, t.FiscalKey, t.MerchantID, t.DebtID, pq.Array(t.Tags), t.Reimbursable,
+		t.RefundOf, sql.NullTime{Time: t.AppliesAt, Valid: !t.AppliesAt.IsZero()}}
+}
+
+// ErrDuplicateReceipt is returned when a transaction for the same fiscal
+// receipt already exists.
+var ErrDuplicateReceipt = errors.New("receipt already recorded")
+
+// ErrDuplicateRow is returned when a transaction with the same row UUID
+// already exists.
+var ErrDuplicateRow = errors.New("row already recorded")
+
 func (r *PostgresRepo) CreateTransaction(t *domain.Transaction) error {
-	_, err := r.db.Exec("INSERT INTO transactions (user_id, amount, category, description) VALUES ($1, $2, $3, $4)",
-		t.UserID, t.Amount, t.Category, t.Description)
+	err := r.db.QueryRow(insertTransaction, insertArgs(t)...).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt, &t.Version, &t.Kind)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		switch pqErr.Constraint {
+		case "transactions_user_fiscal_key":
+			return ErrDuplicateReceipt
+		case "transactions_user_row_uuid":
+			return ErrDuplicateRow
+		}
+	}
 	return err
 }
 
+// CreateTransactions inserts all transactions in one database transaction.
+func (r *PostgresRepo) CreateTransactions(list []*domain.Transaction) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.Prepare(insertTransaction)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, t := range list {
+		if err := stmt.QueryRow(insertArgs(t)...).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt, &t.Version, &t.Kind); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func (r *PostgresRepo) GetBudget(userID int64, category string) (*domain.Budget, error) {
 	var b domain.Budget
-	err := r.db.QueryRow("SELECT category, limit_amount FROM budgets WHERE user_id = $1 AND category = $2", userID, category).Scan(&b.Category, &b.LimitAmount)
+	err := r.db.QueryRow("SELECT category, limit_amount, rollover, rollover_cap, created_at FROM budgets WHERE user_id = $1 AND category = $2", userID, category).
+		Scan(&b.Category, &b.LimitAmount, &b.Rollover, &b.RolloverCap, &b.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
 	return &b, nil
 }
 
-func (r *PostgresRepo) GetTotalSpent(userID int64, category string) (float64, error) {
+// GetTotalSpent returns the spending in [from, to) under a budget scope: a
+// category, all spending, a category group or a tag.
+func (r *PostgresRepo) GetTotalSpent(userID int64, scope string, from, to time.Time) (float64, error) {
+	query := "SELECT COALESCE(SUM(amount), 0) FROM " + spending + " WHERE user_id = $1 AND occurred_at >= $2 AND occurred_at < $3"
+	args := []interface{}{userID, from, to}
+	switch {
+	case scope == domain.ScopeAll:
+	case strings.HasPrefix(scope, domain.ScopeGroupPrefix):
+		query += " AND category IN (SELECT category FROM category_groups WHERE user_id = $1 AND name = $4)"
+		args = append(args, strings.TrimPrefix(scope, domain.ScopeGroupPrefix))
+	case strings.HasPrefix(scope, domain.ScopeTagPrefix):
+		query += " AND $4 = ANY(tags)"
+		args = append(args, strings.TrimPrefix(scope, domain.ScopeTagPrefix))
+	default:
+		query += " AND category = $4"
+		args = append(args, scope)
+	}
+
 	var sum float64
-	err := r.db.QueryRow("SELECT COALESCE(SUM(amount), 0) FROM transactions WHERE user_id = $1 AND category = $2", userID, category).Scan(&sum)
+	err := r.db.QueryRow(query, args...).Scan(&sum)
 	return sum, err
 }
 
-func (r *PostgresRepo) SetBudget(userID int64, category string, limit float64) error {
-	_, err := r.db.Exec(`
-		INSERT INTO budgets (user_id, category, limit_amount) VALUES ($1, $2, $3)
-		ON CONFLICT (user_id, category) DO UPDATE SET limit_amount = $3`,
-		userID, category, limit)
-	return err
+// SetBudget records a limit change effective from the given date and creates
+// or updates the budget, whose limit becomes the latest one in force on today,
+// the user's current date, or zero while none is in force yet. Budget periods
+// from the change onwards are reopened so they are recomputed with the new
+// limit. An empty rollover policy keeps the policy and cap the budget already
+// has.
+func (r *PostgresRepo) SetBudget(userID int64, category string, limit float64, effectiveFrom, today time.Time, rollover string, rolloverCap float64) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setBudgetLimit(tx, userID, category, limit, effectiveFrom, today, rollover, rolloverCap); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SetBudgetLimits records several limit changes in one transaction, keeping
+// the budgets' rollover policies. today is as in SetBudget.
+func (r *PostgresRepo) SetBudgetLimits(userID int64, limits []*domain.BudgetLimit, today time.Time) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, l := range limits {
+		if err := setBudgetLimit(tx, userID, l.Category, l.LimitAmount, l.EffectiveFrom, today, "", 0); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func setBudgetLimit(tx *sql.Tx, userID int64, category string, limit float64, effectiveFrom, today time.Time, rollover string, rolloverCap float64) error {
+	from := effectiveFrom.Format("2006-01-02")
+	if _, err := tx.Exec(`
+		INSERT INTO budget_limits (user_id, category, limit_amount, effective_from) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, category, effective_from) DO UPDATE SET limit_amount = $3, created_at = NOW()`,
+		userID, category, limit, from); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`
+		INSERT INTO budgets (user_id, category, limit_amount, rollover, rollover_cap)
+		VALUES ($1, $2, COALESCE((SELECT limit_amount FROM budget_limits
+				WHERE user_id = $1 AND category = $2 AND effective_from <= $5
+				ORDER BY date_trunc('month', effective_from) DESC, created_at DESC LIMIT 1), 0),
+			COALESCE(NULLIF($3, ''), 'reset'), $4)
+		ON CONFLICT (user_id, category) DO UPDATE SET limit_amount = EXCLUDED.limit_amount,
+			rollover = COALESCE(NULLIF($3, ''), budgets.rollover),
+			rollover_cap = CASE WHEN $3 = '' THEN budgets.rollover_cap ELSE $4 END`,
+		userID, category, rollover, rolloverCap, today.Format("2006-01-02")); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`
+		UPDATE budget_periods SET closed = FALSE
+		WHERE user_id = $1 AND category = $2 AND period_start >= date_trunc('month', $3::date)`,
+		userID, category, from); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListBudgetLimits returns the limit changes of one budget, or of all budgets
+// when category is empty, ordered by category, effective month and the time
+// the change was recorded.
+func (r *PostgresRepo) ListBudgetLimits(userID int64, category string) ([]*domain.BudgetLimit, error) {
+	rows, err := r.db.Query(`
+		SELECT category, limit_amount, effective_from, created_at FROM budget_limits
+		WHERE user_id = $1 AND ($2 = '' OR category = $2)
+		ORDER BY category, date_trunc('month', effective_from), created_at`, userID, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.BudgetLimit
+	for rows.Next() {
+		l := &domain.BudgetLimit{}
+		if err := rows.Scan(&l.Category, &l.LimitAmount, &l.EffectiveFrom, &l.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, l)
+	}
+	return list, rows.Err()
 }
 
 func (r *PostgresRepo) GetReportData(userID int64) (map[string]float64, error) {
-	rows, err := r.db.Query("SELECT category, SUM(amount) FROM transactions WHERE user_id = $1 GROUP BY category", userID)
+	rows, err := r.db.Query("SELECT category, SUM(amount) FROM "+spending+" WHERE user_id = $1 GROUP BY category", userID)
 	if err != nil {
 		return nil, err
 	}
@@ -62,7 +229,7 @@ func (r *PostgresRepo) GetReportData(userID int64) (map[string]float64, error) {
 }
 
 func (r *PostgresRepo) GetBudgets(userID int64) ([]*domain.Budget, error) {
-	rows, err := r.db.Query("SELECT category, limit_amount FROM budgets WHERE user_id = $1", userID)
+	rows, err := r.db.Query("SELECT category, limit_amount, rollover, rollover_cap, created_at FROM budgets WHERE user_id = $1", userID)
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +238,169 @@ func (r *PostgresRepo) GetBudgets(userID int64) ([]*domain.Budget, error) {
 	var list []*domain.Budget
 	for rows.Next() {
 		b := &domain.Budget{}
-		rows.Scan(&b.Category, &b.LimitAmount)
+		rows.Scan(&b.Category, &b.LimitAmount, &b.Rollover, &b.RolloverCap, &b.CreatedAt)
 		list = append(list, b)
 	}
 	return list, nil
 }
+
+func (r *PostgresRepo) ListTransactions(userID int64, from, to time.Time) ([]*domain.Transaction, error) {
+	rows, err := r.db.Query(`
+		SELECT id, amount, category, description, occurred_at, created_at, COALESCE(merchant_id, 0) FROM transactions
+		WHERE user_id = $1 AND occurred_at >= $2 AND occurred_at < $3`+expenseOnly+`
+		ORDER BY occurred_at`, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Transaction
+	for rows.Next() {
+		t := &domain.Transaction{UserID: userID}
+		if err := rows.Scan(&t.ID, &t.Amount, &t.Category, &t.Description, &t.OccurredAt, &t.CreatedAt, &t.MerchantID); err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
+
+func (r *PostgresRepo) GetCategoryTotals(userID int64, from, to time.Time) (map[string]float64, error) {
+	rows, err := r.db.Query(`
+		SELECT category, SUM(amount) FROM `+spending+`
+		WHERE user_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+		GROUP BY category`, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[string]float64)
+	for rows.Next() {
+		var cat string
+		var sum float64
+		if err := rows.Scan(&cat, &sum); err != nil {
+			return nil, err
+		}
+		totals[cat] = sum
+	}
+	return totals, rows.Err()
+}
+
+func (r *PostgresRepo) GetMonthlyTotals(userID int64, from, to time.Time) ([]*domain.MonthlyTotal, error) {
+	rows, err := r.db.Query(`
+		SELECT date_trunc('month', occurred_at AT TIME ZONE $4) AS month, category, SUM(amount) FROM `+spending+`
+		WHERE user_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+		GROUP BY month, category`, userID, from, to, from.Location().String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.MonthlyTotal
+	for rows.Next() {
+		m := &domain.MonthlyTotal{}
+		if err := rows.Scan(&m.Month, &m.Category, &m.Amount); err != nil {
+			return nil, err
+		}
+		list = append(list, m)
+	}
+	return list, rows.Err()
+}
+
+// GetCategoryStats summarises spending per category in [from, to). Totals and
+// means are net of refunds; counts and the amount distribution describe the
+// purchases only.
+func (r *PostgresRepo) GetCategoryStats(userID int64, from, to time.Time) ([]*domain.CategoryStats, error) {
+	rows, err := r.db.Query(`
+		SELECT category, COUNT(*) FILTER (WHERE amount > 0), SUM(amount),
+			COALESCE(SUM(amount) / NULLIF(COUNT(*) FILTER (WHERE amount > 0), 0), 0),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY amount) FILTER (WHERE amount > 0), 0),
+			COALESCE(MIN(amount) FILTER (WHERE amount > 0), 0), COALESCE(MAX(amount) FILTER (WHERE amount > 0), 0),
+			COALESCE(percentile_cont(0.9) WITHIN GROUP (ORDER BY amount) FILTER (WHERE amount > 0), 0)
+		FROM `+spending+`
+		WHERE user_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+		GROUP BY category ORDER BY category`, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.CategoryStats
+	for rows.Next() {
+		c := &domain.CategoryStats{}
+		if err := rows.Scan(&c.Category, &c.Count, &c.Total, &c.Mean, &c.Median, &c.Min, &c.Max, &c.P90); err != nil {
+			return nil, err
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
+// GetWeekdayStats is GetCategoryStats per weekday in from's time zone.
+func (r *PostgresRepo) GetWeekdayStats(userID int64, from, to time.Time) ([]*domain.WeekdayStats, error) {
+	rows, err := r.db.Query(`
+		SELECT EXTRACT(ISODOW FROM occurred_at AT TIME ZONE $4)::INT AS weekday, COUNT(*) FILTER (WHERE amount > 0), SUM(amount)
+		FROM `+spending+`
+		WHERE user_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+		GROUP BY weekday ORDER BY weekday`, userID, from, to, from.Location().String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.WeekdayStats
+	for rows.Next() {
+		w := &domain.WeekdayStats{}
+		if err := rows.Scan(&w.Weekday, &w.Count, &w.Total); err != nil {
+			return nil, err
+		}
+		list = append(list, w)
+	}
+	return list, rows.Err()
+}
+
+// ListCategories returns the categories the user has spent in or budgeted for.
+func (r *PostgresRepo) ListCategories(userID int64) ([]string, error) {
+	rows, err := r.db.Query(`
+		SELECT category FROM transactions WHERE user_id = $1`+expenseOnly+`
+		UNION
+		SELECT category FROM budgets WHERE user_id = $1`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []string
+	for rows.Next() {
+		var c string
+		if err := rows.Scan(&c); err != nil {
+			return nil, err
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// GuessCategory returns the category of most of the user's expenses whose
+// description contains one of words, or "" if there are none.
+func (r *PostgresRepo) GuessCategory(userID int64, words []string) (string, error) {
+	patterns := make([]string, len(words))
+	for i, w := range words {
+		patterns[i] = likeEscaper.Replace(w)
+	}
+
+	var category string
+	err := r.db.QueryRow(`
+		SELECT category FROM transactions
+		WHERE user_id = $1`+expenseOnly+` AND EXISTS (
+			SELECT 1 FROM unnest($2::text[]) w WHERE LOWER(description) LIKE '%' || w || '%')
+		GROUP BY category ORDER BY COUNT(*) DESC, MAX(occurred_at) DESC LIMIT 1`,
+		userID, pq.Array(patterns)).Scan(&category)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return category, err
+}
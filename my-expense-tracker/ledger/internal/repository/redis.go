@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -44,7 +45,40 @@ func (r *RedisRepo) SetReport(ctx context.Context, userID int64, data map[string
 }
 
 func (r *RedisRepo) InvalidateReport(ctx context.Context, userID int64) error {
-	return r.client.Del(ctx, fmt.Sprintf("report:%d", userID)).Err()
+	return r.client.Del(ctx, fmt.Sprintf("report:%d", userID), fmt.Sprintf("stats:%d", userID)).Err()
+}
+
+func (r *RedisRepo) GetStatistics(ctx context.Context, userID int64, from, to time.Time) (*domain.Statistics, error) {
+	val, err := r.client.HGet(ctx, fmt.Sprintf("stats:%d", userID), statsField(from, to)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var stats domain.Statistics
+	if err := json.Unmarshal([]byte(val), &stats); err != nil {
+		return nil, fmt.Errorf("cache deserialization error: %w", err)
+	}
+	return &stats, nil
+}
+
+func (r *RedisRepo) SetStatistics(ctx context.Context, userID int64, stats *domain.Statistics) error {
+	bytes, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("stats:%d", userID)
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, key, statsField(stats.From, stats.To), bytes)
+	pipe.Expire(ctx, key, 30*time.Second)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func statsField(from, to time.Time) string {
+	return from.Format("2006-01-02") + ":" + to.Format("2006-01-02")
 }
 
 func (r *RedisRepo) GetBudgets(ctx context.Context, userID int64) ([]*domain.Budget, error) {
@@ -74,3 +108,131 @@ func (r *RedisRepo) SetBudgets(ctx context.Context, userID int64, list []*domain
 func (r *RedisRepo) InvalidateBudgets(ctx context.Context, userID int64) error {
 	return r.client.Del(ctx, fmt.Sprintf("budgets:%d", userID)).Err()
 }
+
+// idempotencyPending prefixes the request hash of a key whose request is
+// still being processed.
+const idempotencyPending = "pending:"
+
+// idempotentEntry is the stored outcome of a request and the hash of the
+// request it belongs to.
+type idempotentEntry struct {
+	Hash   string
+	Result *domain.TransactionResult
+}
+
+func idempotencyKey(userID int64, key string) string {
+	return fmt.Sprintf("idempotency:%d:%s", userID, key)
+}
+
+// AcquireIdempotencyKey claims the key for the request with the given hash
+// until its result is stored or ttl passes.
+func (r *RedisRepo) AcquireIdempotencyKey(ctx context.Context, userID int64, key, hash string, ttl time.Duration) (bool, error) {
+	return r.client.SetNX(ctx, idempotencyKey(userID, key), idempotencyPending+hash, ttl).Result()
+}
+
+// GetIdempotentResult returns the hash of the request that claimed the key
+// and its result, which is nil while the request is in progress. An empty
+// hash means the key is not claimed.
+func (r *RedisRepo) GetIdempotentResult(ctx context.Context, userID int64, key string) (string, *domain.TransactionResult, error) {
+	val, err := r.client.Get(ctx, idempotencyKey(userID, key)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil, nil
+		}
+		return "", nil, err
+	}
+	if hash, ok := strings.CutPrefix(val, idempotencyPending); ok {
+		return hash, nil, nil
+	}
+
+	var e idempotentEntry
+	if err := json.Unmarshal([]byte(val), &e); err != nil {
+		return "", nil, fmt.Errorf("cache deserialization error: %w", err)
+	}
+	return e.Hash, e.Result, nil
+}
+
+func (r *RedisRepo) SetIdempotentResult(ctx context.Context, userID int64, key, hash string, res *domain.TransactionResult, ttl time.Duration) error {
+	bytes, err := json.Marshal(idempotentEntry{Hash: hash, Result: res})
+	if err != nil {
+		return err
+	}
+	return r.client.Set(ctx, idempotencyKey(userID, key), bytes, ttl).Err()
+}
+
+func (r *RedisRepo) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error {
+	return r.client.Del(ctx, idempotencyKey(userID, key)).Err()
+}
+
+// eventStreamLength caps each user's event stream; older events can no longer
+// be resumed from.
+const eventStreamLength = 1000
+
+func (r *RedisRepo) PublishEvent(ctx context.Context, userID int64, e *domain.LedgerEvent) error {
+	bytes, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	return r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: fmt.Sprintf("events:%d", userID),
+		MaxLen: eventStreamLength,
+		Approx: true,
+		Values: map[string]interface{}{"data": bytes},
+	}).Err()
+}
+
+// LastEventID returns the ID of the newest event in the user's stream, or
+// "0-0" when the stream is empty.
+func (r *RedisRepo) LastEventID(ctx context.Context, userID int64) (string, error) {
+	msgs, err := r.client.XRevRangeN(ctx, fmt.Sprintf("events:%d", userID), "+", "-", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(msgs) == 0 {
+		return "0-0", nil
+	}
+	return msgs[0].ID, nil
+}
+
+// FirstEventID returns the ID of the oldest event still in the user's
+// stream, or "" when the stream is empty.
+func (r *RedisRepo) FirstEventID(ctx context.Context, userID int64) (string, error) {
+	msgs, err := r.client.XRangeN(ctx, fmt.Sprintf("events:%d", userID), "-", "+", 1).Result()
+	if err != nil {
+		return "", err
+	}
+	if len(msgs) == 0 {
+		return "", nil
+	}
+	return msgs[0].ID, nil
+}
+
+// ReadEvents returns events published after lastID, waiting up to block for
+// new ones. An empty slice means nothing arrived in time.
+func (r *RedisRepo) ReadEvents(ctx context.Context, userID int64, lastID string, block time.Duration) ([]*domain.LedgerEvent, error) {
+	streams, err := r.client.XRead(ctx, &redis.XReadArgs{
+		Streams: []string{fmt.Sprintf("events:%d", userID), lastID},
+		Count:   100,
+		Block:   block,
+	}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var list []*domain.LedgerEvent
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			data, _ := msg.Values["data"].(string)
+			e := &domain.LedgerEvent{}
+			if err := json.Unmarshal([]byte(data), e); err != nil {
+				return nil, fmt.Errorf("event deserialization error: %w", err)
+			}
+			e.ID = msg.ID
+			list = append(list, e)
+		}
+	}
+	return list, nil
+}
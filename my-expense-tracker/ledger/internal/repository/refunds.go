@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// ErrHasRefunds is returned when deleting an expense that refunds refer to.
+var ErrHasRefunds = errors.New("transaction has refunds, delete them first")
+
+// GetRefundedAmount returns how much of the expense has been refunded.
+func (r *PostgresRepo) GetRefundedAmount(userID, id int64) (float64, error) {
+	var sum float64
+	err := r.db.QueryRow("SELECT COALESCE(SUM(amount), 0) FROM transactions WHERE user_id = $1 AND refund_of = $2 AND kind = 'refund'",
+		userID, id).Scan(&sum)
+	return sum, err
+}
+
+// CreateRefund records the refund t of the expense t.RefundOf. false is
+// returned when the expense's refunds would exceed its amount. Budget periods
+// from reopenFrom onwards are reopened so they are recomputed with the
+// refund.
+func (r *PostgresRepo) CreateRefund(t *domain.Transaction, reopenFrom time.Time) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var amount, refunded float64
+	if err := tx.QueryRow("SELECT amount FROM transactions WHERE user_id = $1 AND id = $2 FOR UPDATE",
+		t.UserID, t.RefundOf).Scan(&amount); err != nil {
+		return false, err
+	}
+	if err := tx.QueryRow("SELECT COALESCE(SUM(amount), 0) FROM transactions WHERE user_id = $1 AND refund_of = $2 AND kind = 'refund'",
+		t.UserID, t.RefundOf).Scan(&refunded); err != nil {
+		return false, err
+	}
+	if refunded+t.Amount > amount+0.005 {
+		return false, nil
+	}
+
+	if err := tx.QueryRow(insertTransaction, insertArgs(t)...).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt, &t.Version, &t.Kind); err != nil {
+		return false, err
+	}
+	if _, err := tx.Exec("UPDATE budget_periods SET closed = FALSE WHERE user_id = $1 AND period_start >= $2",
+		t.UserID, reopenFrom.Format("2006-01-02")); err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// ListLedger returns the user's entries of every kind in [from, to), newest
+// first, with the refunded amount of each expense.
+func (r *PostgresRepo) ListLedger(userID int64, from, to time.Time, limit int) ([]*domain.Transaction, error) {
+	rows, err := r.db.Query(selectTransaction+`
+		WHERE user_id = $1 AND occurred_at >= $2 AND occurred_at < $3
+		ORDER BY occurred_at DESC, id DESC LIMIT $4`, userID, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Transaction
+	byID := make(map[int64]*domain.Transaction)
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+		byID[t.ID] = t
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return list, nil
+	}
+
+	ids := make([]int64, 0, len(list))
+	for _, t := range list {
+		ids = append(ids, t.ID)
+	}
+	refunds, err := r.db.Query(`
+		SELECT refund_of, SUM(amount) FROM transactions
+		WHERE user_id = $1 AND kind = 'refund' AND refund_of = ANY($2)
+		GROUP BY refund_of`, userID, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer refunds.Close()
+
+	for refunds.Next() {
+		var id int64
+		var sum float64
+		if err := refunds.Scan(&id, &sum); err != nil {
+			return nil, err
+		}
+		byID[id].RefundedAmount = sum
+	}
+	return list, refunds.Err()
+}
@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/lib/pq"
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// ErrExpensesUnavailable is returned when an expense given for a report does
+// not exist, is not an expense, has refunds or is already claimed in another
+// report.
+var ErrExpensesUnavailable = errors.New("expenses not found or already claimed")
+
+// ErrClaimedExpense is returned when deleting an expense on an expense report
+// that is no longer a draft.
+var ErrClaimedExpense = errors.New("expense is on an expense report that is no longer a draft")
+
+// ErrReimbursementEntry is returned when deleting the reimbursement recorded
+// for a paid expense report.
+var ErrReimbursementEntry = errors.New("transaction is the reimbursement of a paid expense report")
+
+const selectExpenseReport = `
+	SELECT id, title, status, created_at, submitted_at, approved_at, paid_at, COALESCE(reimbursement_id, 0), COALESCE(reimbursed_amount, 0)
+	FROM expense_reports`
+
+func scanExpenseReport(row interface{ Scan(...interface{}) error }, userID int64) (*domain.ExpenseReport, error) {
+	rep := &domain.ExpenseReport{UserID: userID}
+	var submittedAt, approvedAt, paidAt sql.NullTime
+	err := row.Scan(&rep.ID, &rep.Title, &rep.Status, &rep.CreatedAt, &submittedAt, &approvedAt, &paidAt,
+		&rep.ReimbursementID, &rep.ReimbursedAmount)
+	if err != nil {
+		return nil, err
+	}
+	rep.SubmittedAt, rep.ApprovedAt, rep.PaidAt = submittedAt.Time, approvedAt.Time, paidAt.Time
+	return rep, nil
+}
+
+// CreateExpenseReport saves a draft report claiming the expenses with the
+// given ids, which are marked reimbursable. Without ids it claims every
+// reimbursable expense that is not in a report yet. The number of claimed
+// expenses is returned; no report is saved when it is zero.
+func (r *PostgresRepo) CreateExpenseReport(rep *domain.ExpenseReport, ids []int64) (int, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if err := tx.QueryRow(`
+		INSERT INTO expense_reports (user_id, title, status) VALUES ($1, $2, $3)
+		RETURNING id, created_at`,
+		rep.UserID, rep.Title, domain.ReportDraft).Scan(&rep.ID, &rep.CreatedAt); err != nil {
+		return 0, err
+	}
+
+	query := `
+		UPDATE transactions SET expense_report_id = $2, reimbursable = TRUE
+		WHERE user_id = $1 AND kind = 'expense' AND expense_report_id IS NULL
+			AND NOT EXISTS (SELECT 1 FROM transactions r WHERE r.refund_of = transactions.id)`
+	args := []interface{}{rep.UserID, rep.ID}
+	if len(ids) > 0 {
+		query += " AND id = ANY($3)"
+		args = append(args, pq.Array(ids))
+	} else {
+		query += " AND reimbursable"
+	}
+	res, err := tx.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) > 0 && int(n) != len(ids) {
+		return 0, ErrExpensesUnavailable
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	rep.Status = domain.ReportDraft
+	return int(n), tx.Commit()
+}
+
+// GetExpenseReport returns the user's report with its expenses, or nil if it
+// does not exist.
+func (r *PostgresRepo) GetExpenseReport(userID, id int64) (*domain.ExpenseReport, error) {
+	rep, err := scanExpenseReport(r.db.QueryRow(selectExpenseReport+" WHERE user_id = $1 AND id = $2", userID, id), userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadReportItems(userID, []*domain.ExpenseReport{rep}); err != nil {
+		return nil, err
+	}
+	return rep, nil
+}
+
+// ListExpenseReports returns the user's reports with their expenses, newest
+// first. An empty status lists reports in every status.
+func (r *PostgresRepo) ListExpenseReports(userID int64, status string) ([]*domain.ExpenseReport, error) {
+	rows, err := r.db.Query(selectExpenseReport+" WHERE user_id = $1 AND ($2 = '' OR status = $2) ORDER BY created_at DESC, id DESC", userID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.ExpenseReport
+	for rows.Next() {
+		rep, err := scanExpenseReport(rows, userID)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, rep)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, r.loadReportItems(userID, list)
+}
+
+// loadReportItems fills in the expenses and totals of the reports.
+func (r *PostgresRepo) loadReportItems(userID int64, list []*domain.ExpenseReport) error {
+	if len(list) == 0 {
+		return nil
+	}
+	byID := make(map[int64]*domain.ExpenseReport, len(list))
+	ids := make([]int64, len(list))
+	for i, rep := range list {
+		byID[rep.ID] = rep
+		ids[i] = rep.ID
+	}
+
+	rows, err := r.db.Query(selectTransaction+" WHERE user_id = $1 AND expense_report_id = ANY($2) ORDER BY occurred_at, id", userID, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return err
+		}
+		rep := byID[t.ExpenseReportID]
+		rep.Items = append(rep.Items, t)
+		rep.Total += t.Amount
+	}
+	return rows.Err()
+}
+
+// SetExpenseReportStatus moves the report from one status to the next and
+// records when it happened. false is returned when the report is not in the
+// from status.
+func (r *PostgresRepo) SetExpenseReportStatus(userID, id int64, from, to string) (bool, error) {
+	res, err := r.db.Exec(`
+		UPDATE expense_reports SET status = $4,
+			submitted_at = CASE WHEN $4 = 'submitted' THEN NOW() ELSE submitted_at END,
+			approved_at = CASE WHEN $4 = 'approved' THEN NOW() ELSE approved_at END
+		WHERE user_id = $1 AND id = $2 AND status = $3`, userID, id, from, to)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// RemoveExpenseReportItem takes the expense off the draft report and clears
+// its reimbursable flag. false is returned when the report is not a draft or
+// the expense is not on it.
+func (r *PostgresRepo) RemoveExpenseReportItem(userID, reportID, transactionID int64) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	var status string
+	err = tx.QueryRow("SELECT status FROM expense_reports WHERE user_id = $1 AND id = $2 FOR UPDATE", userID, reportID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil || status != domain.ReportDraft {
+		return false, err
+	}
+
+	res, err := tx.Exec(`
+		UPDATE transactions SET expense_report_id = NULL, reimbursable = FALSE
+		WHERE user_id = $1 AND id = $3 AND expense_report_id = $2`, userID, reportID, transactionID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil || n == 0 {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// ReimburseExpenseReport records the reimbursement t for an approved report,
+// marks the report paid and its expenses reimbursed. false is returned when
+// the report is not approved.
+func (r *PostgresRepo) ReimburseExpenseReport(rep *domain.ExpenseReport, t *domain.Transaction) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	if err := tx.QueryRow(insertTransaction, insertArgs(t)...).Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt, &t.Version, &t.Kind); err != nil {
+		return false, err
+	}
+
+	err = tx.QueryRow(`
+		UPDATE expense_reports SET status = $3, paid_at = NOW(), reimbursement_id = $4, reimbursed_amount = $5
+		WHERE user_id = $1 AND id = $2 AND status = $6
+		RETURNING paid_at`,
+		rep.UserID, rep.ID, domain.ReportPaid, t.ID, t.Amount, domain.ReportApproved).Scan(&rep.PaidAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := tx.Exec("UPDATE transactions SET reimbursed = TRUE WHERE user_id = $1 AND expense_report_id = $2", rep.UserID, rep.ID); err != nil {
+		return false, err
+	}
+	rep.Status, rep.ReimbursementID, rep.ReimbursedAmount = domain.ReportPaid, t.ID, t.Amount
+	return true, tx.Commit()
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// TestExpenseReportClaim needs a database migrated by the ledger in
+// TEST_DATABASE_URL.
+func TestExpenseReportClaim(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	repo := NewPostgresRepo(db)
+
+	userID := time.Now().UnixNano() % 1_000_000_000
+	defer func() {
+		db.Exec("UPDATE expense_reports SET reimbursement_id = NULL WHERE user_id = $1", userID)
+		db.Exec("DELETE FROM transactions WHERE user_id = $1", userID)
+		db.Exec("DELETE FROM expense_reports WHERE user_id = $1", userID)
+	}()
+
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	var ids []int64
+	for _, amount := range []float64{1200, 800} {
+		tr := &domain.Transaction{UserID: userID, Amount: amount, Category: "Такси", Kind: domain.KindExpense, OccurredAt: now}
+		if err := repo.CreateTransaction(tr); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, tr.ID)
+	}
+
+	rep := &domain.ExpenseReport{UserID: userID, Title: "Командировка"}
+	if n, err := repo.CreateExpenseReport(rep, ids); err != nil || n != 2 {
+		t.Fatalf("CreateExpenseReport() = %d, %v, want 2", n, err)
+	}
+
+	// Only drafts can be changed.
+	if _, err := repo.SetExpenseReportStatus(userID, rep.ID, domain.ReportDraft, domain.ReportSubmitted); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := repo.RemoveExpenseReportItem(userID, rep.ID, ids[1]); err != nil || ok {
+		t.Errorf("RemoveExpenseReportItem() on a submitted report = %v, %v, want false", ok, err)
+	}
+	if _, err := repo.SetExpenseReportStatus(userID, rep.ID, domain.ReportSubmitted, domain.ReportDraft); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := repo.RemoveExpenseReportItem(userID, rep.ID, ids[1]); err != nil || !ok {
+		t.Errorf("RemoveExpenseReportItem() = %v, %v, want true", ok, err)
+	}
+	if ok, err := repo.RemoveExpenseReportItem(userID, rep.ID, ids[1]); err != nil || ok {
+		t.Errorf("RemoveExpenseReportItem() again = %v, %v, want false", ok, err)
+	}
+	removed, err := repo.GetTransaction(userID, ids[1])
+	if err != nil || removed.ExpenseReportID != 0 || removed.Reimbursable {
+		t.Errorf("removed expense = %+v, %v, want unclaimed and not reimbursable", removed, err)
+	}
+
+	// Claimed expenses count as spending until the report is paid.
+	for _, step := range [][2]string{{domain.ReportDraft, domain.ReportSubmitted}, {domain.ReportSubmitted, domain.ReportApproved}} {
+		if _, err := repo.SetExpenseReportStatus(userID, rep.ID, step[0], step[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if spent, err := repo.GetTotalSpent(userID, domain.ScopeAll, month, month.AddDate(0, 1, 0)); err != nil || spent != 2000 {
+		t.Errorf("GetTotalSpent() with the report approved = %v, %v, want 2000", spent, err)
+	}
+	if _, _, err := repo.DeleteTransaction(userID, ids[0], 0); !errors.Is(err, ErrClaimedExpense) {
+		t.Errorf("DeleteTransaction() of a claimed expense error = %v, want %v", err, ErrClaimedExpense)
+	}
+	if rep, err = repo.GetExpenseReport(userID, rep.ID); err != nil {
+		t.Fatal(err)
+	}
+	reimbursement := &domain.Transaction{UserID: userID, Amount: 1200, Category: "Возмещение", Kind: domain.KindReimbursement, OccurredAt: now}
+	if ok, err := repo.ReimburseExpenseReport(rep, reimbursement); err != nil || !ok {
+		t.Fatalf("ReimburseExpenseReport() = %v, %v, want true", ok, err)
+	}
+	if spent, err := repo.GetTotalSpent(userID, domain.ScopeAll, month, month.AddDate(0, 1, 0)); err != nil || spent != 800 {
+		t.Errorf("GetTotalSpent() with the report paid = %v, %v, want 800", spent, err)
+	}
+	if _, _, err := repo.DeleteTransaction(userID, reimbursement.ID, 0); !errors.Is(err, ErrReimbursementEntry) {
+		t.Errorf("DeleteTransaction() of the reimbursement error = %v, want %v", err, ErrReimbursementEntry)
+	}
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const selectBudgetPeriod = `
+	SELECT category, period_start, base_limit, carryover, spent, closed
+	FROM budget_periods`
+
+func scanBudgetPeriod(row interface{ Scan(...interface{}) error }) (*domain.BudgetPeriod, error) {
+	p := &domain.BudgetPeriod{}
+	if err := row.Scan(&p.Category, &p.PeriodStart, &p.BaseLimit, &p.Carryover, &p.Spent, &p.Closed); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ListBudgetPeriods returns the stored history of a budget, oldest first.
+func (r *PostgresRepo) ListBudgetPeriods(userID int64, category string) ([]*domain.BudgetPeriod, error) {
+	rows, err := r.db.Query(selectBudgetPeriod+" WHERE user_id = $1 AND category = $2 ORDER BY period_start", userID, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.BudgetPeriod
+	for rows.Next() {
+		p, err := scanBudgetPeriod(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
+
+// GetBudgetPeriod returns the budget's month starting at start, or nil if it
+// is not stored.
+func (r *PostgresRepo) GetBudgetPeriod(userID int64, category string, start time.Time) (*domain.BudgetPeriod, error) {
+	p, err := scanBudgetPeriod(r.db.QueryRow(selectBudgetPeriod+" WHERE user_id = $1 AND category = $2 AND period_start = $3",
+		userID, category, start.Format("2006-01-02")))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return p, err
+}
+
+func (r *PostgresRepo) SaveBudgetPeriod(userID int64, p *domain.BudgetPeriod) error {
+	_, err := r.db.Exec(`
+		INSERT INTO budget_periods (user_id, category, period_start, base_limit, carryover, spent, closed)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, category, period_start) DO UPDATE
+		SET base_limit = $4, carryover = $5, spent = $6, closed = $7`,
+		userID, p.Category, p.PeriodStart.Format("2006-01-02"), p.BaseLimit, p.Carryover, p.Spent, p.Closed)
+	return err
+}
+
+// ReopenBudgetPeriods marks the user's budget months from the month starting
+// at from on as open.
+func (r *PostgresRepo) ReopenBudgetPeriods(userID int64, from time.Time) error {
+	_, err := r.db.Exec("UPDATE budget_periods SET closed = FALSE WHERE user_id = $1 AND period_start >= $2",
+		userID, from.Format("2006-01-02"))
+	return err
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// Search finds the user's transactions whose description matches q.Text,
+// best matches first. The russian text search configuration stems Russian
+// words and handles Latin-script words with the English stemmer, so one
+// search_vector column covers both languages. Zero From/To and an empty
+// Category are not filtered on.
+func (r *PostgresRepo) Search(userID int64, q *domain.SearchQuery) ([]*domain.SearchResult, error) {
+	args := []interface{}{userID, q.Text}
+	var filters strings.Builder
+	if q.Category != "" {
+		args = append(args, q.Category)
+		fmt.Fprintf(&filters, " AND LOWER(category) = LOWER($%d)", len(args))
+	}
+	if !q.From.IsZero() {
+		args = append(args, q.From)
+		fmt.Fprintf(&filters, " AND occurred_at >= $%d", len(args))
+	}
+	if !q.To.IsZero() {
+		args = append(args, q.To)
+		fmt.Fprintf(&filters, " AND occurred_at < $%d", len(args))
+	}
+	args = append(args, q.Limit, q.Offset)
+
+	rows, err := r.db.Query(`
+		SELECT id, amount, category, description, occurred_at, created_at, kind,
+			ts_rank(search_vector, query) AS rank,
+			ts_headline('russian', description, query, 'HighlightAll=true')
+		FROM transactions, websearch_to_tsquery('russian', $2) query
+		WHERE user_id = $1 AND search_vector @@ query`+filters.String()+`
+		ORDER BY rank DESC, occurred_at DESC
+		LIMIT $`+fmt.Sprint(len(args)-1)+` OFFSET $`+fmt.Sprint(len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.SearchResult
+	for rows.Next() {
+		t := &domain.Transaction{UserID: userID}
+		res := &domain.SearchResult{Transaction: t}
+		if err := rows.Scan(&t.ID, &t.Amount, &t.Category, &t.Description, &t.OccurredAt, &t.CreatedAt, &t.Kind,
+			&res.Rank, &res.Highlight); err != nil {
+			return nil, err
+		}
+		list = append(list, res)
+	}
+	return list, rows.Err()
+}
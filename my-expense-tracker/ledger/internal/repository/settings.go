@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// GetTimezone returns the user's IANA timezone, or "" when none is set.
+func (r *PostgresRepo) GetTimezone(userID int64) (string, error) {
+	var tz string
+	err := r.db.QueryRow("SELECT COALESCE(timezone, '') FROM user_settings WHERE user_id = $1", userID).Scan(&tz)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return tz, err
+}
+
+func (r *PostgresRepo) SetTimezone(userID int64, tz string) error {
+	_, err := r.db.Exec(`
+		INSERT INTO user_settings (user_id, timezone) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET timezone = $2`,
+		userID, tz)
+	return err
+}
+
+// GetBudgetMode returns the user's budget mode and, in envelope mode, when it
+// was switched on. Users without settings use monthly limits.
+func (r *PostgresRepo) GetBudgetMode(userID int64) (string, time.Time, error) {
+	var mode string
+	var since sql.NullTime
+	err := r.db.QueryRow("SELECT budget_mode, envelopes_since FROM user_settings WHERE user_id = $1", userID).Scan(&mode, &since)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.BudgetModeLimits, time.Time{}, nil
+	}
+	return mode, since.Time, err
+}
+
+// SetBudgetMode changes the budget mode. Switching to envelopes starts them
+// afresh from the current moment.
+func (r *PostgresRepo) SetBudgetMode(userID int64, mode string) error {
+	_, err := r.db.Exec(`
+		INSERT INTO user_settings (user_id, budget_mode, envelopes_since)
+		VALUES ($1, $2, CASE WHEN $2 = 'envelopes' THEN NOW() END)
+		ON CONFLICT (user_id) DO UPDATE SET budget_mode = $2,
+			envelopes_since = CASE
+				WHEN user_settings.budget_mode = $2 THEN user_settings.envelopes_since
+				WHEN $2 = 'envelopes' THEN NOW()
+			END`,
+		userID, mode)
+	return err
+}
+
+// GetRefundAttribution returns where the user's refunds reduce spending.
+// Users without settings attribute refunds to the original purchase.
+func (r *PostgresRepo) GetRefundAttribution(userID int64) (string, error) {
+	var attribution string
+	err := r.db.QueryRow("SELECT refund_attribution FROM user_settings WHERE user_id = $1", userID).Scan(&attribution)
+	if errors.Is(err, sql.ErrNoRows) {
+		return domain.RefundToPurchase, nil
+	}
+	return attribution, err
+}
+
+func (r *PostgresRepo) SetRefundAttribution(userID int64, attribution string) error {
+	_, err := r.db.Exec(`
+		INSERT INTO user_settings (user_id, refund_attribution) VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET refund_attribution = $2`,
+		userID, attribution)
+	return err
+}
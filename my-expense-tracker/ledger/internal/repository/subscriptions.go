@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// ListActiveUsers returns the users with spending since the given time.
+func (r *PostgresRepo) ListActiveUsers(since time.Time) ([]int64, error) {
+	rows, err := r.db.Query("SELECT DISTINCT user_id FROM transactions WHERE occurred_at >= $1"+expenseOnly, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		list = append(list, id)
+	}
+	return list, rows.Err()
+}
+
+func (r *PostgresRepo) ListSubscriptions(userID int64) ([]*domain.Subscription, error) {
+	rows, err := r.db.Query(`
+		SELECT id, key, name, category, cadence, amount, previous_amount, occurrences,
+			last_charge, next_charge, yearly_cost, last_transaction_id, price_changed_at
+		FROM subscriptions WHERE user_id = $1
+		ORDER BY yearly_cost DESC, name`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Subscription
+	for rows.Next() {
+		s := &domain.Subscription{UserID: userID}
+		var changedAt sql.NullTime
+		if err := rows.Scan(&s.ID, &s.Key, &s.Name, &s.Category, &s.Cadence, &s.Amount, &s.PreviousAmount, &s.Occurrences,
+			&s.LastCharge, &s.NextCharge, &s.YearlyCost, &s.LastID, &changedAt); err != nil {
+			return nil, err
+		}
+		s.PriceChangedAt = changedAt.Time
+		list = append(list, s)
+	}
+	return list, rows.Err()
+}
+
+// SaveSubscriptions stores list as the user's current subscriptions. A
+// subscription keeps its id for as long as it is detected; the ones no longer
+// detected are removed.
+func (r *PostgresRepo) SaveSubscriptions(userID int64, list []*domain.Subscription) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	keys := make([]string, 0, len(list))
+	for _, s := range list {
+		var changedAt sql.NullTime
+		if !s.PriceChangedAt.IsZero() {
+			changedAt = sql.NullTime{Time: s.PriceChangedAt, Valid: true}
+		}
+		err := tx.QueryRow(`
+			INSERT INTO subscriptions (user_id, key, name, category, cadence, amount, previous_amount, occurrences,
+				last_charge, next_charge, yearly_cost, last_transaction_id, price_changed_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			ON CONFLICT (user_id, key) DO UPDATE SET name = EXCLUDED.name, category = EXCLUDED.category,
+				cadence = EXCLUDED.cadence, amount = EXCLUDED.amount, previous_amount = EXCLUDED.previous_amount,
+				occurrences = EXCLUDED.occurrences, last_charge = EXCLUDED.last_charge, next_charge = EXCLUDED.next_charge,
+				yearly_cost = EXCLUDED.yearly_cost, last_transaction_id = EXCLUDED.last_transaction_id,
+				price_changed_at = EXCLUDED.price_changed_at
+			RETURNING id`,
+			userID, s.Key, s.Name, s.Category, s.Cadence, s.Amount, s.PreviousAmount, s.Occurrences,
+			s.LastCharge, s.NextCharge, s.YearlyCost, s.LastID, changedAt).Scan(&s.ID)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, s.Key)
+	}
+	if _, err := tx.Exec("DELETE FROM subscriptions WHERE user_id = $1 AND NOT (key = ANY($2))", userID, pq.Array(keys)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// CreatePriceAlert records that the subscription's price went up with its
+// last charge. False is returned when the alert was already recorded.
+func (r *PostgresRepo) CreatePriceAlert(s *domain.Subscription) (bool, error) {
+	res, err := r.db.Exec(`
+		INSERT INTO subscription_price_alerts (user_id, subscription_key, transaction_id, amount, previous_amount)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, subscription_key, transaction_id) DO NOTHING`,
+		s.UserID, s.Key, s.LastID, s.Amount, s.PreviousAmount)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/lib/pq"
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const selectTransaction = `
+	SELECT id, user_id, amount, category, description, occurred_at, created_at, COALESCE(row_uuid, ''), version, updated_at, kind, COALESCE(goal_id, 0), COALESCE(fiscal_key, ''), COALESCE(merchant_id, 0), COALESCE(debt_id, 0), tags,
+		reimbursable, reimbursed, COALESCE(expense_report_id, 0), COALESCE(refund_of, 0), applies_at
+	FROM transactions`
+
+func scanTransaction(row interface{ Scan(...interface{}) error }) (*domain.Transaction, error) {
+	t := &domain.Transaction{}
+	var appliesAt sql.NullTime
+	err := row.Scan(&t.ID, &t.UserID, &t.Amount, &t.Category, &t.Description, &t.OccurredAt, &t.CreatedAt, &t.RowUUID, &t.Version, &t.UpdatedAt, &t.Kind, &t.GoalID, &t.FiscalKey, &t.MerchantID, &t.DebtID, pq.Array(&t.Tags),
+		&t.Reimbursable, &t.Reimbursed, &t.ExpenseReportID, &t.RefundOf, &appliesAt)
+	if err != nil {
+		return nil, err
+	}
+	t.AppliesAt = appliesAt.Time
+	return t, nil
+}
+
+// GetTransaction returns the user's transaction by id, or nil if it does not exist.
+func (r *PostgresRepo) GetTransaction(userID, id int64) (*domain.Transaction, error) {
+	t, err := scanTransaction(r.db.QueryRow(selectTransaction+" WHERE user_id = $1 AND id = $2", userID, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// GetTransactionIDsByRowUUID returns the ids of the user's transactions with
+// the given client row UUIDs, by UUID.
+func (r *PostgresRepo) GetTransactionIDsByRowUUID(userID int64, rowUUIDs []string) (map[string]int64, error) {
+	rows, err := r.db.Query("SELECT row_uuid, id FROM transactions WHERE user_id = $1 AND row_uuid = ANY($2)", userID, pq.Array(rowUUIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := make(map[string]int64)
+	for rows.Next() {
+		var rowUUID string
+		var id int64
+		if err := rows.Scan(&rowUUID, &id); err != nil {
+			return nil, err
+		}
+		ids[rowUUID] = id
+	}
+	return ids, rows.Err()
+}
+
+// GetTransactionByRowUUID returns the user's transaction with the given client
+// row UUID, or nil if it does not exist.
+func (r *PostgresRepo) GetTransactionByRowUUID(userID int64, rowUUID string) (*domain.Transaction, error) {
+	t, err := scanTransaction(r.db.QueryRow(selectTransaction+" WHERE user_id = $1 AND row_uuid = $2", userID, rowUUID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// GetTransactionByFiscalKey returns the user's transaction recorded from the
+// given fiscal receipt, or nil if there is none.
+func (r *PostgresRepo) GetTransactionByFiscalKey(userID int64, key string) (*domain.Transaction, error) {
+	t, err := scanTransaction(r.db.QueryRow(selectTransaction+" WHERE user_id = $1 AND fiscal_key = $2", userID, key))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return t, err
+}
+
+// UpdateTransaction overwrites the editable fields of t. A non-zero
+// expectedVersion makes the update conditional on the stored version; false is
+// returned when no row matched.
+func (r *PostgresRepo) UpdateTransaction(t *domain.Transaction, expectedVersion int) (bool, error) {
+	err := r.db.QueryRow(`
+		UPDATE transactions
+		SET amount = $3, category = $4, description = $5, occurred_at = $6, merchant_id = NULLIF($8, 0),
+			version = version + 1, updated_at = NOW(), change_seq = nextval('transaction_changes'), change_xid = pg_current_xact_id()
+		WHERE user_id = $1 AND id = $2 AND ($7 = 0 OR version = $7)
+		RETURNING version, updated_at`,
+		t.UserID, t.ID, t.Amount, t.Category, t.Description, t.OccurredAt, expectedVersion, t.MerchantID).Scan(&t.Version, &t.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// DeleteTransaction removes the transaction with its attachments and leaves a
+// tombstone so sync clients learn about the deletion. The storage keys of the
+// removed attachments are returned for their contents to be deleted. The
+// version check works as in UpdateTransaction. Expenses on an expense report
+// can only be deleted while it is a draft, and reimbursements not at all.
+func (r *PostgresRepo) DeleteTransaction(userID, id int64, expectedVersion int) (bool, []string, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, nil, err
+	}
+	defer tx.Rollback()
+
+	// Lock the expense's report so it is not submitted meanwhile.
+	var status string
+	err = tx.QueryRow(`
+		SELECT r.status FROM transactions t JOIN expense_reports r ON r.id = t.expense_report_id
+		WHERE t.user_id = $1 AND t.id = $2 FOR SHARE OF r`, userID, id).Scan(&status)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return false, nil, err
+	}
+	if status != "" && status != domain.ReportDraft {
+		return false, nil, ErrClaimedExpense
+	}
+
+	if _, err := tx.Exec("DELETE FROM anomalies WHERE transaction_id = $1 AND user_id = $2", id, userID); err != nil {
+		return false, nil, err
+	}
+
+	rows, err := tx.Query("DELETE FROM attachments WHERE transaction_id = $1 AND user_id = $2 RETURNING storage_key", id, userID)
+	if err != nil {
+		return false, nil, err
+	}
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			rows.Close()
+			return false, nil, err
+		}
+		keys = append(keys, key)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return false, nil, err
+	}
+
+	var rowUUID sql.NullString
+	var kind string
+	err = tx.QueryRow(`
+		DELETE FROM transactions WHERE user_id = $1 AND id = $2 AND ($3 = 0 OR version = $3)
+		RETURNING row_uuid, kind`, userID, id, expectedVersion).Scan(&rowUUID, &kind)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil, nil
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		switch pqErr.Constraint {
+		case "transactions_refund_of_fkey":
+			return false, nil, ErrHasRefunds
+		case "expense_reports_reimbursement_id_fkey":
+			return false, nil, ErrReimbursementEntry
+		}
+	}
+	if err != nil {
+		return false, nil, err
+	}
+
+	if _, err := tx.Exec(`
+		INSERT INTO transaction_tombstones (user_id, transaction_id, row_uuid, kind) VALUES ($1, $2, $3, $4)`,
+		userID, id, rowUUID, kind); err != nil {
+		return false, nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, nil, err
+	}
+	return true, keys, nil
+}
+
+// IsDeleted reports whether a transaction with the id or row UUID was deleted.
+func (r *PostgresRepo) IsDeleted(userID, id int64, rowUUID string) (bool, error) {
+	var exists bool
+	err := r.db.QueryRow(`
+		SELECT EXISTS (SELECT 1 FROM transaction_tombstones
+		WHERE user_id = $1 AND (transaction_id = $2 OR (row_uuid IS NOT NULL AND row_uuid = $3)))`,
+		userID, id, rowUUID).Scan(&exists)
+	return exists, err
+}
+
+// GetChanges returns expenses changed and deleted after the change (sinceXID,
+// sinceSeq), ordered by the database transaction that made each change and
+// then by change sequence number. Only changes of transactions older than
+// every one still running are returned, so a change that commits later always
+// sorts after them; horizon is the transaction id from which changes were
+// held back.
+func (r *PostgresRepo) GetChanges(userID int64, sinceXID uint64, sinceSeq int64, limit int) ([]*domain.Change, uint64, error) {
+	tx, err := r.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, 0, err
+	}
+	defer tx.Rollback()
+
+	var horizon uint64
+	if err := tx.QueryRow("SELECT pg_snapshot_xmin(pg_current_snapshot())").Scan(&horizon); err != nil {
+		return nil, 0, err
+	}
+
+	after := `user_id = $1 AND kind = 'expense'
+		AND (change_xid > $2::xid8 OR (change_xid = $2::xid8 AND change_seq > $3))
+		AND change_xid < $4::xid8`
+	rows, err := tx.Query(`
+		SELECT change_xid, change_seq, FALSE, id, amount, category, description, occurred_at, COALESCE(row_uuid, ''), version, updated_at
+		FROM transactions WHERE `+after+`
+		UNION ALL
+		SELECT change_xid, change_seq, TRUE, transaction_id, 0, '', '', deleted_at, COALESCE(row_uuid, ''), 0, deleted_at
+		FROM transaction_tombstones WHERE `+after+`
+		ORDER BY 1, 2 LIMIT $5`, userID, strconv.FormatUint(sinceXID, 10), sinceSeq, strconv.FormatUint(horizon, 10), limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var list []*domain.Change
+	for rows.Next() {
+		c := &domain.Change{Transaction: &domain.Transaction{UserID: userID}}
+		t := c.Transaction
+		if err := rows.Scan(&c.XID, &c.Seq, &c.Deleted, &t.ID, &t.Amount, &t.Category, &t.Description,
+			&t.OccurredAt, &t.RowUUID, &t.Version, &t.UpdatedAt); err != nil {
+			return nil, 0, err
+		}
+		list = append(list, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+	return list, horizon, tx.Commit()
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestGetChangesConcurrentCommit needs a database migrated by the ledger in
+// TEST_DATABASE_URL.
+func TestGetChangesConcurrentCommit(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	repo := NewPostgresRepo(db)
+
+	userID := time.Now().UnixNano() % 1_000_000_000
+	defer db.Exec("DELETE FROM transactions WHERE user_id = $1", userID)
+	insert := func(tx *sql.Tx, amount float64) int64 {
+		var id int64
+		if err := tx.QueryRow("INSERT INTO transactions (user_id, amount, category, description) VALUES ($1, $2, 'Еда', '') RETURNING id",
+			userID, amount).Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		return id
+	}
+
+	// The first transaction takes its change number before the second one
+	// but commits after it.
+	slow, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer slow.Rollback()
+	slowID := insert(slow, 100)
+
+	fast, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fastID := insert(fast, 200)
+	if err := fast.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	seen := make(map[int64]bool)
+	list, horizon, err := repo.GetChanges(userID, 0, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	xid, seq := horizon, int64(0)
+	for _, c := range list {
+		seen[c.Transaction.ID] = true
+		xid, seq = c.XID, c.Seq
+	}
+
+	if err := slow.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	list, _, err = repo.GetChanges(userID, xid, seq, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range list {
+		seen[c.Transaction.ID] = true
+	}
+
+	if !seen[slowID] || !seen[fastID] {
+		t.Errorf("changes seen = %v, want %d and %d", seen, slowID, fastID)
+	}
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// SaveBudgetTemplate creates the template or replaces the items of the
+// user's template with the same name.
+func (r *PostgresRepo) SaveBudgetTemplate(t *domain.BudgetTemplate) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRow(`
+		INSERT INTO budget_templates (user_id, name) VALUES ($1, $2)
+		ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id, created_at`, t.UserID, t.Name).Scan(&t.ID, &t.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec("DELETE FROM budget_template_items WHERE template_id = $1", t.ID); err != nil {
+		return err
+	}
+	for _, item := range t.Items {
+		if _, err := tx.Exec("INSERT INTO budget_template_items (template_id, category, limit_amount) VALUES ($1, $2, $3)",
+			t.ID, item.Category, item.LimitAmount); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// GetBudgetTemplate returns the user's template by name, or nil if it does not
+// exist.
+func (r *PostgresRepo) GetBudgetTemplate(userID int64, name string) (*domain.BudgetTemplate, error) {
+	t := &domain.BudgetTemplate{UserID: userID}
+	err := r.db.QueryRow("SELECT id, name, created_at FROM budget_templates WHERE user_id = $1 AND name = $2", userID, name).
+		Scan(&t.ID, &t.Name, &t.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query("SELECT category, limit_amount FROM budget_template_items WHERE template_id = $1 ORDER BY category", t.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &domain.BudgetTemplateItem{}
+		if err := rows.Scan(&item.Category, &item.LimitAmount); err != nil {
+			return nil, err
+		}
+		t.Items = append(t.Items, item)
+	}
+	return t, rows.Err()
+}
+
+func (r *PostgresRepo) ListBudgetTemplates(userID int64) ([]*domain.BudgetTemplate, error) {
+	rows, err := r.db.Query(`
+		SELECT t.id, t.name, t.created_at, i.category, i.limit_amount
+		FROM budget_templates t LEFT JOIN budget_template_items i ON i.template_id = t.id
+		WHERE t.user_id = $1
+		ORDER BY t.name, i.category`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*domain.BudgetTemplate
+	for rows.Next() {
+		var t domain.BudgetTemplate
+		var category sql.NullString
+		var limit sql.NullFloat64
+		if err := rows.Scan(&t.ID, &t.Name, &t.CreatedAt, &category, &limit); err != nil {
+			return nil, err
+		}
+		if len(list) == 0 || list[len(list)-1].ID != t.ID {
+			t.UserID = userID
+			list = append(list, &t)
+		}
+		if category.Valid {
+			last := list[len(list)-1]
+			last.Items = append(last.Items, &domain.BudgetTemplateItem{Category: category.String, LimitAmount: limit.Float64})
+		}
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	anomalyLookback   = 180 * 24 * time.Hour
+	anomalyMinHistory = 5
+	anomalyThreshold  = 3.5
+	anomalyRatio      = 10
+	duplicateWindow   = 24 * time.Hour
+)
+
+const (
+	ReasonUnusualAmount = "unusual_amount"
+	ReasonNewMerchant   = "new_merchant"
+	ReasonDuplicate     = "possible_duplicate"
+)
+
+// anomalyDetector scores a user's new transactions. What it knows of the
+// user's merchants is loaded once, so a batch is scored like single
+// transactions are, with its earlier rows counting as known.
+type anomalyDetector struct {
+	s         *LedgerService
+	userID    int64
+	loaded    bool
+	total     int
+	known     map[string]bool
+	merchants map[int64]bool
+}
+
+func (s *LedgerService) newAnomalyDetector(userID int64) *anomalyDetector {
+	return &anomalyDetector{s: s, userID: userID}
+}
+
+// detectAnomaly scores a single transaction.
+func (s *LedgerService) detectAnomaly(t *domain.Transaction) (float64, []string) {
+	return s.newAnomalyDetector(t.UserID).detect(t)
+}
+
+// load reads the number of expenses and the merchants they were bought from.
+// false is returned when either lookup failed.
+func (d *anomalyDetector) load() bool {
+	if d.loaded {
+		return d.known != nil
+	}
+	d.loaded = true
+
+	total, err := d.s.pg.CountTransactions(d.userID)
+	if err != nil {
+		log.Printf("Anomaly error (CountTransactions): %v", err)
+		return false
+	}
+	list, err := d.s.pg.ListExpenseMerchants(d.userID)
+	if err != nil {
+		log.Printf("Anomaly error (ListExpenseMerchants): %v", err)
+		return false
+	}
+	d.total = total
+	d.known = make(map[string]bool)
+	d.merchants = make(map[int64]bool)
+	for _, t := range list {
+		d.remember(t)
+	}
+	return true
+}
+
+func (d *anomalyDetector) remember(t *domain.Transaction) {
+	if key := normalizeDescriptor(t.Description); key != "" {
+		d.known[key] = true
+	}
+	if t.MerchantID > 0 {
+		d.merchants[t.MerchantID] = true
+	}
+}
+
+// detect scores t against the user's recent history in its category and
+// lists the reasons it looks suspicious. A merchant is new when neither the
+// merchant t is linked to nor its normalized description was seen before.
+// Lookup errors are logged and the corresponding check is skipped, so
+// scoring never blocks a transaction.
+func (d *anomalyDetector) detect(t *domain.Transaction) (float64, []string) {
+	var reasons []string
+
+	history, err := d.s.pg.GetCategoryAmounts(t.UserID, t.Category, time.Now().Add(-anomalyLookback))
+	if err != nil {
+		log.Printf("Anomaly error (GetCategoryAmounts): %v", err)
+	}
+	score := amountScore(t.Amount, history)
+	if score > anomalyThreshold || (len(history) > 0 && t.Amount >= anomalyRatio*median(history)) {
+		reasons = append(reasons, ReasonUnusualAmount)
+	}
+
+	if key := normalizeDescriptor(t.Description); key != "" && d.load() {
+		if d.total >= anomalyMinHistory && !d.known[key] && !d.merchants[t.MerchantID] {
+			reasons = append(reasons, ReasonNewMerchant)
+		}
+		d.remember(t)
+		d.total++
+	}
+
+	dup, err := d.s.pg.HasSimilarTransaction(t, time.Now().Add(-duplicateWindow))
+	if err != nil {
+		log.Printf("Anomaly error (HasSimilarTransaction): %v", err)
+	} else if dup {
+		reasons = append(reasons, ReasonDuplicate)
+	}
+
+	return score, reasons
+}
+
+// amountScore returns the modified z-score of x against history, based on the
+// median absolute deviation. Only amounts above the median get a positive
+// score. When the history is too short to be meaningful the score is 0.
+func amountScore(x float64, history []float64) float64 {
+	if len(history) < anomalyMinHistory {
+		return 0
+	}
+	med := median(history)
+	if x <= med {
+		return 0
+	}
+
+	deviations := make([]float64, len(history))
+	var meanDev float64
+	for i, v := range history {
+		deviations[i] = math.Abs(v - med)
+		meanDev += deviations[i]
+	}
+	meanDev /= float64(len(history))
+
+	if mad := median(deviations); mad > 0 {
+		return round2(0.6745 * (x - med) / mad)
+	}
+	if meanDev > 0 {
+		return round2((x - med) / (1.253314 * meanDev))
+	}
+	if med > 0 {
+		return round2((x - med) / med)
+	}
+	return 0
+}
+
+func (s *LedgerService) ListAnomalies(ctx context.Context, userID int64, includeReviewed bool) ([]*domain.Anomaly, error) {
+	return s.pg.ListAnomalies(userID, includeReviewed)
+}
+
+func (s *LedgerService) ReviewAnomaly(ctx context.Context, userID, id int64) error {
+	return s.pg.ReviewAnomaly(userID, id)
+}
@@ -0,0 +1,59 @@
+package service
+
+import "testing"
+
+func TestAmountScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  float64
+		history []float64
+		flagged bool
+	}{
+		{
+			name:    "Typical amount",
+			amount:  320,
+			history: []float64{250, 300, 280, 350, 310, 290},
+			flagged: false,
+		},
+		{
+			name:    "Ten times larger",
+			amount:  3000,
+			history: []float64{250, 300, 280, 350, 310, 290},
+			flagged: true,
+		},
+		{
+			name:    "Smaller than usual",
+			amount:  10,
+			history: []float64{250, 300, 280, 350, 310, 290},
+			flagged: false,
+		},
+		{
+			name:    "Short history",
+			amount:  3000,
+			history: []float64{300, 280},
+			flagged: false,
+		},
+		{
+			name:    "Identical history",
+			amount:  5000,
+			history: []float64{999, 999, 999, 999, 999},
+			flagged: true,
+		},
+		{
+			name:    "Mostly identical history",
+			amount:  1500,
+			history: []float64{500, 500, 500, 500, 500, 520},
+			flagged: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := amountScore(tt.amount, tt.history)
+
+			if (score > anomalyThreshold) != tt.flagged {
+				t.Errorf("amountScore() = %v, flagged %v", score, tt.flagged)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// MaxAttachmentSize is the largest accepted attachment. The gRPC message size
+// limits of the ledger server and the gateway are derived from it.
+const MaxAttachmentSize = 10 << 20
+
+// attachmentTypes are the accepted content types, as detected from the file
+// contents rather than trusted from the client.
+var attachmentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+func (s *LedgerService) AddAttachment(ctx context.Context, userID, transactionID int64, filename string, data []byte) (*domain.Attachment, error) {
+	contentType, err := attachmentType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.pg.GetTransaction(userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, errors.New("transaction not found")
+	}
+
+	key, err := attachmentKey(userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.blobs.Put(key, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	a := &domain.Attachment{
+		UserID:        userID,
+		TransactionID: transactionID,
+		Filename:      cleanFilename(filename),
+		ContentType:   contentType,
+		Size:          int64(len(data)),
+		StorageKey:    key,
+	}
+	if err := s.pg.CreateAttachment(a); err != nil {
+		if err := s.blobs.Delete(key); err != nil {
+			log.Printf("Blob error (Delete): %v", err)
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetAttachment returns the attachment and its contents. Attachments of other
+// users are reported as not found.
+func (s *LedgerService) GetAttachment(ctx context.Context, userID, id int64) (*domain.Attachment, []byte, error) {
+	a, err := s.pg.GetAttachment(userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if a == nil {
+		return nil, nil, ErrAttachmentNotFound
+	}
+
+	r, err := s.blobs.Get(a.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, data, nil
+}
+
+func (s *LedgerService) ListAttachments(ctx context.Context, userID, transactionID int64) ([]*domain.Attachment, error) {
+	return s.pg.ListAttachments(userID, transactionID)
+}
+
+// attachmentType checks the size of an attachment and returns its content
+// type.
+func attachmentType(data []byte) (string, error) {
+	if len(data) == 0 {
+		return "", errors.New("attachment is empty")
+	}
+	if len(data) > MaxAttachmentSize {
+		return "", fmt.Errorf("attachment is larger than %d MB", MaxAttachmentSize>>20)
+	}
+	contentType := http.DetectContentType(data)
+	if !attachmentTypes[contentType] {
+		return "", fmt.Errorf("unsupported attachment type %s", contentType)
+	}
+	return contentType, nil
+}
+
+// deleteBlobs removes the contents of deleted attachments.
+func (s *LedgerService) deleteBlobs(keys []string) {
+	for _, key := range keys {
+		if err := s.blobs.Delete(key); err != nil {
+			log.Printf("Blob error (Delete): %v", err)
+		}
+	}
+}
+
+func attachmentKey(userID int64) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d/%s", userID, hex.EncodeToString(b)), nil
+}
+
+func cleanFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" {
+		return "attachment"
+	}
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+	return name
+}
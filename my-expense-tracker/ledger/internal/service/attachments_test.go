@@ -0,0 +1,38 @@
+package service
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestAttachmentType(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	pdf := []byte("%PDF-1.7\n")
+
+	tests := []struct {
+		name    string
+		data    []byte
+		want    string
+		wantErr bool
+	}{
+		{name: "PNG", data: png, want: "image/png"},
+		{name: "PDF", data: pdf, want: "application/pdf"},
+		{name: "Largest accepted size", data: append(pdf, bytes.Repeat([]byte{0}, MaxAttachmentSize-len(pdf))...), want: "application/pdf"},
+		{name: "Too large", data: append(pdf, bytes.Repeat([]byte{0}, MaxAttachmentSize-len(pdf)+1)...), wantErr: true},
+		{name: "Empty", data: nil, wantErr: true},
+		{name: "Plain text", data: []byte("receipt total 350"), wantErr: true},
+		{name: "HTML named as an image", data: []byte("<html><script>alert(1)</script></html>"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := attachmentType(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("attachmentType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("attachmentType() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
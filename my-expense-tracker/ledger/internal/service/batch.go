@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+	"github.com/yuramishin/expense-tracker/ledger/internal/repository"
+)
+
+// BatchMaxRows is the largest number of rows one batch may hold.
+const BatchMaxRows = 1000
+
+func validateTransaction(t *domain.Transaction) error {
+	t.Tags = normalizeTags(t.Tags)
+	if t.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+	if err := validateCategory(t.Category); err != nil {
+		return err
+	}
+	return validateTags(t.Tags)
+}
+
+type budgetPeriod struct {
+	category string
+	start    time.Time
+}
+
+// CreateTransactions validates and budget-checks the rows as one batch, then
+// inserts them. Rows are scored for anomalies like single transactions. In
+// atomic mode a single failing row rejects the whole batch and everything is
+// inserted in one database transaction; otherwise each valid row is inserted
+// on its own. Rows whose row UUID was imported before are skipped, so a
+// retried import does not insert them twice.
+func (s *LedgerService) CreateTransactions(ctx context.Context, userID int64, rows []*domain.BatchRow, atomic bool) *domain.BatchResult {
+	results := make([]*domain.BatchRowResult, len(rows))
+	for i, r := range rows {
+		results[i] = &domain.BatchRowResult{Row: r.Row, Success: true}
+		switch {
+		case r.Error != "":
+			results[i].Success, results[i].Message = false, r.Error
+		case i >= BatchMaxRows:
+			results[i].Success, results[i].Message = false, fmt.Sprintf("Batch is limited to %d rows", BatchMaxRows)
+		default:
+			if r.Transaction.OccurredAt.IsZero() {
+				r.Transaction.OccurredAt = time.Now()
+			}
+			if err := validateTransaction(r.Transaction); err != nil {
+				results[i].Success, results[i].Message = false, err.Error()
+			}
+		}
+	}
+
+	imported := s.findImported(userID, rows, results)
+	budgets, keys := s.checkBatchBudgets(userID, rows, results)
+
+	var pending []*domain.Transaction
+	var pendingIdx []int
+	for i, r := range rows {
+		if results[i].Success {
+			pending = append(pending, r.Transaction)
+			pendingIdx = append(pendingIdx, i)
+		}
+	}
+
+	scores := make([]float64, len(rows))
+	reasons := make([][]string, len(rows))
+	complete := len(pending)+len(imported) == len(rows)
+	if !atomic || complete {
+		s.resolveMerchants(userID, pending)
+		detector := s.newAnomalyDetector(userID)
+		for n, t := range pending {
+			i := pendingIdx[n]
+			scores[i], reasons[i] = detector.detect(t)
+		}
+	}
+
+	if atomic {
+		var err error
+		if !complete {
+			err = errors.New("batch has invalid rows")
+		} else if err = s.pg.CreateTransactions(pending); err != nil {
+			log.Printf("DB error (CreateTransactions): %v", err)
+		}
+		if err != nil {
+			for _, res := range results {
+				if res.Success {
+					res.Success, res.Message = false, "Rolled back: batch was not saved"
+				}
+			}
+			pending = nil
+		}
+	} else {
+		for n, t := range pending {
+			err := s.pg.CreateTransaction(t)
+			if errors.Is(err, repository.ErrDuplicateRow) {
+				results[pendingIdx[n]].Success, results[pendingIdx[n]].Message = false, "Row was imported by another request"
+			} else if err != nil {
+				log.Printf("DB error (CreateTransaction): %v", err)
+				results[pendingIdx[n]].Success, results[pendingIdx[n]].Message = false, "DB Error"
+			}
+		}
+	}
+
+	// Thresholds are reported against what was actually inserted.
+	for _, b := range budgets {
+		b.after = b.before
+	}
+	batch := &domain.BatchResult{Results: results}
+	for i, res := range results {
+		if _, ok := imported[i]; ok {
+			res.Success = true
+			batch.Skipped++
+			continue
+		}
+		if !res.Success {
+			batch.Failed++
+			continue
+		}
+		t := rows[i].Transaction
+		res.Message = "Saved"
+		res.TransactionID = t.ID
+		res.AnomalyScore, res.AnomalyReasons = scores[i], reasons[i]
+		batch.Inserted++
+		if len(reasons[i]) > 0 {
+			a := &domain.Anomaly{UserID: userID, TransactionID: t.ID, Score: scores[i], Reasons: reasons[i]}
+			if err := s.pg.CreateAnomaly(a); err != nil {
+				log.Printf("DB error (CreateAnomaly): %v", err)
+			}
+		}
+		s.publishTransaction(t)
+		for _, key := range keys[i] {
+			budgets[key].after += t.Amount
+		}
+	}
+	for key, b := range budgets {
+		if !b.envelope && !b.unlimited {
+			s.publishThresholds(userID, key.category, b.limit, b.before, b.after)
+		}
+	}
+
+	if batch.Inserted > 0 {
+		var dates []time.Time
+		for i, res := range results {
+			if _, ok := imported[i]; res.Success && !ok {
+				dates = append(dates, rows[i].Transaction.OccurredAt)
+			}
+		}
+		s.reopenBudgetPeriods(userID, dates...)
+		go func() {
+			if err := s.redis.InvalidateReport(context.Background(), userID); err != nil {
+				log.Printf("Redis error (InvalidateReport): %v", err)
+			}
+		}()
+	}
+	return batch
+}
+
+// findImported returns the ids of the transactions that rows with an already
+// imported row UUID were saved as, by row index. Such rows are taken out of
+// the batch until the results are reported. A row repeating the row UUID of
+// an earlier row fails.
+func (s *LedgerService) findImported(userID int64, rows []*domain.BatchRow, results []*domain.BatchRowResult) map[int]int64 {
+	imported := make(map[int]int64)
+	seen := make(map[string]bool)
+	var uuids []string
+	for i, r := range rows {
+		id := r.Transaction.RowUUID
+		if !results[i].Success || id == "" {
+			continue
+		}
+		if seen[id] {
+			results[i].Success, results[i].Message = false, "Row UUID repeats an earlier row"
+			continue
+		}
+		seen[id] = true
+		uuids = append(uuids, id)
+	}
+	if len(uuids) == 0 {
+		return imported
+	}
+
+	ids, err := s.pg.GetTransactionIDsByRowUUID(userID, uuids)
+	if err != nil {
+		// The unique row UUID index still keeps the rows from being
+		// inserted twice.
+		log.Printf("DB error (GetTransactionIDsByRowUUID): %v", err)
+		return imported
+	}
+	for i, r := range rows {
+		if id, ok := ids[r.Transaction.RowUUID]; ok && results[i].Success {
+			imported[i] = id
+			results[i].Success, results[i].Message, results[i].TransactionID = false, "Already imported", id
+		}
+	}
+	return imported
+}
+
+type batchBudget struct {
+	limit    float64
+	before   float64
+	after    float64
+	envelope bool
+	// unlimited marks a month in which the budget had no limit yet.
+	unlimited bool
+}
+
+// checkBatchBudgets applies the budget check to rows that are still valid,
+// counting earlier rows of the batch towards the spending of later ones. It
+// returns the budget state per period and the periods every row counts
+// towards.
+func (s *LedgerService) checkBatchBudgets(userID int64, rows []*domain.BatchRow, results []*domain.BatchRowResult) (map[budgetPeriod]*batchBudget, [][]budgetPeriod) {
+	states := make(map[budgetPeriod]*batchBudget)
+	keys := make([][]budgetPeriod, len(rows))
+
+	envelopes, since, err := s.envelopeBalances(userID)
+	if err != nil {
+		log.Printf("DB error (envelopeBalances): %v", err)
+		return states, keys
+	}
+	if envelopes != nil {
+		checkBatchEnvelopes(rows, results, envelopes, since, states, keys)
+		return states, keys
+	}
+
+	covering, err := s.coveringBudgets(userID)
+	if err != nil {
+		log.Printf("DB error (coveringBudgets): %v", err)
+		return states, keys
+	}
+
+	loc := s.userLocation(userID)
+	for i, r := range rows {
+		if !results[i].Success {
+			continue
+		}
+		t := r.Transaction
+
+		var rowKeys []budgetPeriod
+		for _, b := range covering(t) {
+			key := budgetPeriod{category: b.Category, start: monthStart(t.OccurredAt.In(loc))}
+			state, ok := states[key]
+			if !ok {
+				spent, _ := s.pg.GetTotalSpent(userID, b.Category, key.start, key.start.AddDate(0, 1, 0))
+				limit, ok := s.budgetLimit(userID, b, key.start)
+				state = &batchBudget{limit: limit, before: spent, after: spent, unlimited: !ok}
+				states[key] = state
+			}
+			if state.unlimited {
+				continue
+			}
+			if state.after+t.Amount > state.limit {
+				results[i].Success = false
+				results[i].Message = budgetExceeded(b.Category, state.limit, state.after)
+				rowKeys = nil
+				break
+			}
+			rowKeys = append(rowKeys, key)
+		}
+		for _, key := range rowKeys {
+			states[key].after += t.Amount
+		}
+		keys[i] = rowKeys
+	}
+	return states, keys
+}
+
+// checkBatchEnvelopes is the envelope mode counterpart of checkBatchBudgets:
+// each funded category is one period whose limit is the envelope balance.
+func checkBatchEnvelopes(rows []*domain.BatchRow, results []*domain.BatchRowResult, envelopes map[string]float64, since time.Time, states map[budgetPeriod]*batchBudget, keys [][]budgetPeriod) {
+	for i, r := range rows {
+		t := r.Transaction
+		balance, ok := envelopes[t.Category]
+		if !results[i].Success || !ok || t.OccurredAt.Before(since) {
+			continue
+		}
+
+		key := budgetPeriod{category: t.Category}
+		state, ok := states[key]
+		if !ok {
+			state = &batchBudget{limit: balance, envelope: true}
+			states[key] = state
+		}
+		if state.after+t.Amount > state.limit {
+			results[i].Success = false
+			results[i].Message = fmt.Sprintf("Envelope exceeded! Available: %.0f", state.limit-state.after)
+			continue
+		}
+		state.after += t.Amount
+		keys[i] = []budgetPeriod{key}
+	}
+}
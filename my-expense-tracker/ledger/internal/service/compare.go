@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const compareTopMovers = 3
+
+func (s *LedgerService) CompareReport(ctx context.Context, userID int64, month string) (*domain.Comparison, error) {
+	start, err := parseMonth(month, s.userLocation(userID))
+	if err != nil {
+		return nil, err
+	}
+	prev := start.AddDate(0, -1, 0)
+	yearAgo := start.AddDate(-1, 0, 0)
+
+	current, err := s.pg.GetCategoryTotals(userID, start, start.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.pg.GetCategoryTotals(userID, prev, start)
+	if err != nil {
+		return nil, err
+	}
+	lastYear, err := s.pg.GetCategoryTotals(userID, yearAgo, yearAgo.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	cats := make(map[string]bool)
+	for _, totals := range []map[string]float64{current, previous, lastYear} {
+		for cat := range totals {
+			cats[cat] = true
+		}
+	}
+
+	list := make([]*domain.CategoryComparison, 0, len(cats))
+	for cat := range cats {
+		c := &domain.CategoryComparison{
+			Category: cat,
+			Current:  current[cat],
+			Previous: previous[cat],
+			YearAgo:  lastYear[cat],
+		}
+		c.ChangePrevious = round2(c.Current - c.Previous)
+		c.ChangePreviousPct, c.NewPrevious = percentChange(c.Current, c.Previous)
+		c.ChangeYearAgo = round2(c.Current - c.YearAgo)
+		c.ChangeYearAgoPct, c.NewYearAgo = percentChange(c.Current, c.YearAgo)
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Category < list[j].Category })
+
+	movers, newcomers := rankMovers(list)
+	return &domain.Comparison{
+		Month:      start,
+		Previous:   prev,
+		YearAgo:    yearAgo,
+		Categories: list,
+		TopMovers:  movers,
+		Newcomers:  newcomers,
+	}, nil
+}
+
+// rankMovers returns the categories whose spending changed most since the
+// previous month, and separately the categories that had no spending then,
+// largest first, as their change has no percentage to compare.
+func rankMovers(list []*domain.CategoryComparison) (movers, newcomers []*domain.CategoryComparison) {
+	for _, c := range list {
+		if c.NewPrevious {
+			newcomers = append(newcomers, c)
+		} else {
+			movers = append(movers, c)
+		}
+	}
+	sort.SliceStable(movers, func(i, j int) bool {
+		return math.Abs(movers[i].ChangePrevious) > math.Abs(movers[j].ChangePrevious)
+	})
+	sort.SliceStable(newcomers, func(i, j int) bool { return newcomers[i].Current > newcomers[j].Current })
+	if len(movers) > compareTopMovers {
+		movers = movers[:compareTopMovers]
+	}
+	if len(newcomers) > compareTopMovers {
+		newcomers = newcomers[:compareTopMovers]
+	}
+	return movers, newcomers
+}
+
+// percentChange returns the change from base to value in percent. Spending
+// with nothing in the base period to compare to has no percentage and is
+// reported as new instead.
+func percentChange(value, base float64) (pct float64, isNew bool) {
+	if base == 0 {
+		return 0, value > 0
+	}
+	return round2((value - base) / base * 100), false
+}
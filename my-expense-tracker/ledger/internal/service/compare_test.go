@@ -0,0 +1,68 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestPercentChange(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     float64
+		base      float64
+		wantPct   float64
+		wantIsNew bool
+	}{
+		{name: "Increase", value: 1500, base: 1000, wantPct: 50},
+		{name: "Decrease", value: 250, base: 1000, wantPct: -75},
+		{name: "Stopped spending", value: 0, base: 1000, wantPct: -100},
+		{name: "New spending", value: 800, base: 0, wantIsNew: true},
+		{name: "Nothing in either period", value: 0, base: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pct, isNew := percentChange(tt.value, tt.base)
+			if pct != tt.wantPct || isNew != tt.wantIsNew {
+				t.Errorf("percentChange() = %v, %v, want %v, %v", pct, isNew, tt.wantPct, tt.wantIsNew)
+			}
+		})
+	}
+}
+
+func TestRankMovers(t *testing.T) {
+	compare := func(category string, current, previous float64) *domain.CategoryComparison {
+		c := &domain.CategoryComparison{Category: category, Current: current, Previous: previous, ChangePrevious: current - previous}
+		c.ChangePreviousPct, c.NewPrevious = percentChange(current, previous)
+		return c
+	}
+	list := []*domain.CategoryComparison{
+		compare("Аптека", 300, 0),
+		compare("Еда", 12000, 10000),
+		compare("Кафе", 1000, 4000),
+		compare("Подарки", 9000, 0),
+		compare("Такси", 2100, 2000),
+		compare("Транспорт", 1500, 1000),
+	}
+
+	movers, newcomers := rankMovers(list)
+
+	wantMovers := []string{"Кафе", "Еда", "Транспорт"}
+	wantNewcomers := []string{"Подарки", "Аптека"}
+	if got := categoryNames(movers); !reflect.DeepEqual(got, wantMovers) {
+		t.Errorf("movers = %v, want %v", got, wantMovers)
+	}
+	if got := categoryNames(newcomers); !reflect.DeepEqual(got, wantNewcomers) {
+		t.Errorf("newcomers = %v, want %v", got, wantNewcomers)
+	}
+}
+
+func categoryNames(list []*domain.CategoryComparison) []string {
+	names := make([]string, len(list))
+	for i, c := range list {
+		names[i] = c.Category
+	}
+	return names
+}
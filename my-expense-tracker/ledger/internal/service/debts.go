@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	// debtMaxMonths caps an amortisation schedule at 50 years.
+	debtMaxMonths = 600
+	// debtCategory is the category of recorded debt payments.
+	debtCategory = "Долги"
+)
+
+func (s *LedgerService) CreateDebt(ctx context.Context, d *domain.Debt, startDate string) (*domain.Debt, error) {
+	d.Counterparty = strings.TrimSpace(d.Counterparty)
+	switch {
+	case d.Counterparty == "":
+		return nil, errors.New("counterparty cannot be empty")
+	case len(d.Counterparty) > 100:
+		return nil, errors.New("counterparty name too long")
+	case d.Principal <= 0:
+		return nil, errors.New("principal must be positive")
+	case d.AnnualRate < 0 || d.AnnualRate > 1000:
+		return nil, errors.New("interest rate must be between 0 and 1000 percent")
+	case d.TermMonths < 0 || d.TermMonths > debtMaxMonths:
+		return nil, fmt.Errorf("term must be between 0 and %d months", debtMaxMonths)
+	case d.Payment < 0:
+		return nil, errors.New("payment cannot be negative")
+	}
+	switch d.Direction {
+	case "":
+		d.Direction = domain.DebtBorrowed
+	case domain.DebtBorrowed, domain.DebtLent:
+	default:
+		return nil, fmt.Errorf("unknown direction %q", d.Direction)
+	}
+
+	loc := s.userLocation(d.UserID)
+	if startDate == "" {
+		d.StartDate = dayStart(time.Now().In(loc))
+	} else {
+		var err error
+		if d.StartDate, err = time.ParseInLocation(dateLayout, startDate, loc); err != nil {
+			return nil, fmt.Errorf("invalid start date %q, expected YYYY-MM-DD", startDate)
+		}
+	}
+
+	if err := s.pg.CreateDebt(d); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// RecordDebtPayment records a payment on a debt as a ledger entry. For lent
+// money it is a repayment received from the counterparty.
+func (s *LedgerService) RecordDebtPayment(ctx context.Context, userID, debtID int64, amount float64, description string, occurredAt time.Time) *domain.TransactionResult {
+	d, err := s.pg.GetDebt(userID, debtID)
+	if err != nil {
+		log.Printf("DB error (GetDebt): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if d == nil {
+		return &domain.TransactionResult{Success: false, Message: "Debt not found"}
+	}
+
+	if description == "" {
+		description = d.Counterparty
+	}
+	t := &domain.Transaction{
+		UserID:      userID,
+		Amount:      amount,
+		Category:    debtCategory,
+		Description: description,
+		OccurredAt:  occurredAt,
+		Kind:        domain.KindDebtPayment,
+		DebtID:      d.ID,
+	}
+	return s.recordEntry(t)
+}
+
+func (s *LedgerService) ListDebts(ctx context.Context, userID int64) ([]*domain.DebtStatus, error) {
+	debts, err := s.pg.ListDebts(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(s.userLocation(userID))
+	list := make([]*domain.DebtStatus, 0, len(debts))
+	for _, d := range debts {
+		payments, err := s.pg.ListDebtPayments(userID, d.ID)
+		if err != nil {
+			return nil, err
+		}
+		d.StartDate = dateIn(d.StartDate, now.Location())
+		list = append(list, debtStatus(d, payments, now))
+	}
+	return list, nil
+}
+
+// GetDebtSchedule returns the debt's balance, the amortisation table of the
+// remaining scheduled payments and a payoff projection for each extra
+// monthly payment.
+func (s *LedgerService) GetDebtSchedule(ctx context.Context, userID, debtID int64, extras []float64) (*domain.DebtStatus, error) {
+	d, err := s.pg.GetDebt(userID, debtID)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return nil, errors.New("debt not found")
+	}
+	payments, err := s.pg.ListDebtPayments(userID, d.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(s.userLocation(userID))
+	d.StartDate = dateIn(d.StartDate, now.Location())
+	status := debtStatus(d, payments, now)
+
+	rate := d.AnnualRate / 100 / 12
+	first := nextDueDate(d.StartDate, now)
+	status.Schedule, _ = amortise(status.Balance, rate, status.Payment, first)
+
+	base := projectPayoff(status.Balance, rate, status.Payment, 0, first)
+	status.Projections = append(status.Projections, base)
+	for _, extra := range extras {
+		if extra <= 0 {
+			continue
+		}
+		p := projectPayoff(status.Balance, rate, status.Payment, extra, first)
+		if base.PaysOff && p.PaysOff {
+			p.MonthsSaved = base.Months - p.Months
+			p.InterestSaved = round2(base.TotalInterest - p.TotalInterest)
+		}
+		status.Projections = append(status.Projections, p)
+	}
+	return status, nil
+}
+
+// debtStatus replays the payments against the debt. Interest accrues daily
+// at the annual rate on the remaining balance, and each payment covers the
+// accrued interest before the principal.
+func debtStatus(d *domain.Debt, payments []*domain.Transaction, now time.Time) *domain.DebtStatus {
+	st := &domain.DebtStatus{Debt: d, Balance: d.Principal}
+	daily := d.AnnualRate / 100 / 365
+
+	last := d.StartDate
+	accrue := func(until time.Time) {
+		if days := until.Sub(last).Hours() / 24; days > 0 {
+			st.AccruedInterest += st.Balance * daily * days
+			last = until
+		}
+	}
+	for _, p := range payments {
+		accrue(p.OccurredAt)
+		interest := math.Min(p.Amount, st.AccruedInterest)
+		st.AccruedInterest -= interest
+		st.InterestPaid += interest
+		principal := math.Min(p.Amount-interest, st.Balance)
+		st.Balance -= principal
+		st.PrincipalPaid += principal
+	}
+	accrue(now)
+
+	st.Payment = d.Payment
+	if st.Payment == 0 && d.TermMonths > 0 {
+		st.Payment = scheduledPayment(d.Principal, d.AnnualRate/100/12, d.TermMonths)
+	}
+
+	st.Balance = round2(st.Balance)
+	st.AccruedInterest = round2(st.AccruedInterest)
+	st.PrincipalPaid = round2(st.PrincipalPaid)
+	st.InterestPaid = round2(st.InterestPaid)
+	st.Outstanding = round2(st.Balance + st.AccruedInterest)
+	return st
+}
+
+// scheduledPayment is the fixed monthly payment that repays principal over
+// the given number of months at the monthly rate.
+func scheduledPayment(principal, rate float64, months int) float64 {
+	if rate == 0 {
+		return round2(principal / float64(months))
+	}
+	return round2(principal * rate / (1 - math.Pow(1+rate, -float64(months))))
+}
+
+// nextDueDate returns the first monthly anniversary of start after now.
+func nextDueDate(start, now time.Time) time.Time {
+	for n := 1; ; n++ {
+		if d := start.AddDate(0, n, 0); d.After(now) {
+			return d
+		}
+	}
+}
+
+// amortise builds the monthly schedule that repays balance with the given
+// payment, starting at first. Interest is charged monthly on the remaining
+// balance and the last payment covers only what is left. The second result
+// is false when the payment does not cover the interest or the schedule
+// would exceed debtMaxMonths.
+func amortise(balance, rate, payment float64, first time.Time) ([]*domain.AmortisationRow, bool) {
+	var rows []*domain.AmortisationRow
+	for n := 1; balance > 0.005; n++ {
+		interest := round2(balance * rate)
+		if payment <= interest || n > debtMaxMonths {
+			return rows, false
+		}
+		pay := math.Min(payment, round2(balance+interest))
+		balance = round2(balance + interest - pay)
+		rows = append(rows, &domain.AmortisationRow{
+			N:         n,
+			Date:      first.AddDate(0, n-1, 0),
+			Payment:   pay,
+			Interest:  interest,
+			Principal: round2(pay - interest),
+			Balance:   balance,
+		})
+	}
+	return rows, true
+}
+
+func projectPayoff(balance, rate, payment, extra float64, first time.Time) *domain.PayoffProjection {
+	p := &domain.PayoffProjection{ExtraPayment: extra}
+	rows, ok := amortise(balance, rate, payment+extra, first)
+	if !ok {
+		return p
+	}
+	p.PaysOff = true
+	p.Months = len(rows)
+	if len(rows) > 0 {
+		p.PayoffDate = rows[len(rows)-1].Date
+	}
+	for _, r := range rows {
+		p.TotalInterest += r.Interest
+	}
+	p.TotalInterest = round2(p.TotalInterest)
+	return p
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestScheduledPayment(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal float64
+		rate      float64
+		months    int
+		want      float64
+	}{
+		{name: "Interest free", principal: 1200, rate: 0, months: 12, want: 100},
+		{name: "Twelve percent a year", principal: 100000, rate: 0.01, months: 12, want: 8884.88},
+		{name: "Car loan", principal: 1500000, rate: 0.15 / 12, months: 60, want: 35684.9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scheduledPayment(tt.principal, tt.rate, tt.months); got != tt.want {
+				t.Errorf("scheduledPayment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmortise(t *testing.T) {
+	first := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	rows, ok := amortise(100000, 0.01, 8884.88, first)
+	if !ok || len(rows) != 12 {
+		t.Fatalf("amortise() = %d rows, ok %v, want 12 rows", len(rows), ok)
+	}
+	last := rows[len(rows)-1]
+	if last.Balance != 0 || !last.Date.Equal(first.AddDate(0, 11, 0)) {
+		t.Errorf("last row = %+v, want zero balance on %v", last, first.AddDate(0, 11, 0))
+	}
+	var principal float64
+	for _, r := range rows {
+		principal += r.Principal
+	}
+	if round2(principal) != 100000 {
+		t.Errorf("principal repaid = %v, want 100000", round2(principal))
+	}
+
+	if _, ok := amortise(100000, 0.01, 1000, first); ok {
+		t.Error("amortise() with a payment equal to the interest should not pay off")
+	}
+
+	base := projectPayoff(100000, 0.01, 8884.88, 0, first)
+	extra := projectPayoff(100000, 0.01, 8884.88, 5000, first)
+	if !extra.PaysOff || extra.Months >= base.Months || extra.TotalInterest >= base.TotalInterest {
+		t.Errorf("extra payment projection = %+v, base %+v", extra, base)
+	}
+}
+
+func TestDebtStatus(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	d := &domain.Debt{Principal: 10000, AnnualRate: 0, TermMonths: 10, StartDate: start}
+	payments := []*domain.Transaction{
+		{Amount: 1000, OccurredAt: start.AddDate(0, 1, 0)},
+		{Amount: 1500, OccurredAt: start.AddDate(0, 2, 0)},
+	}
+
+	st := debtStatus(d, payments, start.AddDate(0, 3, 0))
+	if st.Balance != 7500 || st.Outstanding != 7500 || st.Payment != 1000 || st.InterestPaid != 0 {
+		t.Errorf("debtStatus() = %+v", st)
+	}
+
+	d.AnnualRate = 36.5
+	st = debtStatus(d, nil, start.AddDate(0, 0, 10))
+	if st.Balance != 10000 || st.AccruedInterest != 100 {
+		t.Errorf("debtStatus() with interest = %+v", st)
+	}
+}
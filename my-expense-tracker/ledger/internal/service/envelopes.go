@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	// incomeCategory is used for income recorded without a category.
+	incomeCategory = "Доход"
+	// envelopeMovesShown is how many recent moves GetEnvelopes returns.
+	envelopeMovesShown = 20
+)
+
+// budgetMode returns the user's budget mode and when envelopes were switched
+// on, falling back to monthly limits when the settings cannot be read.
+func (s *LedgerService) budgetMode(userID int64) (string, time.Time) {
+	mode, since, err := s.pg.GetBudgetMode(userID)
+	if err != nil {
+		log.Printf("DB error (GetBudgetMode): %v", err)
+		return domain.BudgetModeLimits, time.Time{}
+	}
+	return mode, since
+}
+
+func (s *LedgerService) GetBudgetMode(ctx context.Context, userID int64) string {
+	mode, _ := s.budgetMode(userID)
+	return mode
+}
+
+// SetBudgetMode switches between monthly limits and envelopes. Envelopes
+// start empty and only count income and spending from the switch onwards.
+func (s *LedgerService) SetBudgetMode(ctx context.Context, userID int64, mode string) error {
+	switch mode {
+	case domain.BudgetModeLimits, domain.BudgetModeEnvelopes:
+	default:
+		return fmt.Errorf("unknown budget mode %q", mode)
+	}
+	return s.pg.SetBudgetMode(userID, mode)
+}
+
+// RecordIncome records income, which funds the unassigned pool in envelope
+// mode.
+func (s *LedgerService) RecordIncome(ctx context.Context, userID int64, amount float64, category, description string, occurredAt time.Time) *domain.TransactionResult {
+	if category == "" {
+		category = incomeCategory
+	}
+	return s.recordEntry(&domain.Transaction{
+		UserID:      userID,
+		Amount:      amount,
+		Category:    category,
+		Description: description,
+		OccurredAt:  occurredAt,
+		Kind:        domain.KindIncome,
+	})
+}
+
+// MoveEnvelope allocates money from the unassigned pool to an envelope,
+// moves it between envelopes or returns it to the pool. Only money that is
+// available at the source can be moved.
+func (s *LedgerService) MoveEnvelope(ctx context.Context, m *domain.EnvelopeMove) error {
+	m.FromCategory = strings.TrimSpace(m.FromCategory)
+	m.ToCategory = strings.TrimSpace(m.ToCategory)
+	switch {
+	case m.Amount <= 0:
+		return errors.New("amount must be positive")
+	case m.FromCategory == m.ToCategory:
+		return errors.New("source and destination must differ")
+	}
+	for _, cat := range []string{m.FromCategory, m.ToCategory} {
+		if cat == "" {
+			continue
+		}
+		if err := validateCategory(cat); err != nil {
+			return err
+		}
+	}
+
+	mode, since := s.budgetMode(m.UserID)
+	if mode != domain.BudgetModeEnvelopes {
+		return errors.New("envelope budgeting is not enabled")
+	}
+
+	return s.pg.CreateEnvelopeMove(m, since, func(income float64, allocated, spent map[string]float64) error {
+		summary := buildEnvelopeSummary(since, income, allocated, spent)
+		available := summary.Unassigned
+		if m.FromCategory != "" {
+			available = 0
+			for _, e := range summary.Envelopes {
+				if e.Category == m.FromCategory {
+					available = e.Balance
+				}
+			}
+		}
+		if m.Amount > available {
+			return fmt.Errorf("not enough money to move: available %.2f", available)
+		}
+		return nil
+	})
+}
+
+func (s *LedgerService) GetEnvelopes(ctx context.Context, userID int64) (*domain.EnvelopeSummary, error) {
+	mode, since := s.budgetMode(userID)
+	if mode != domain.BudgetModeEnvelopes {
+		return nil, errors.New("envelope budgeting is not enabled")
+	}
+
+	summary, err := s.envelopeSummary(userID, since)
+	if err != nil {
+		return nil, err
+	}
+	if summary.Moves, err = s.pg.ListEnvelopeMoves(userID, since, envelopeMovesShown); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// envelopeSummary computes the pool and the balance of every envelope that
+// has been funded since the given time.
+func (s *LedgerService) envelopeSummary(userID int64, since time.Time) (*domain.EnvelopeSummary, error) {
+	income, err := s.pg.GetIncome(userID, since)
+	if err != nil {
+		return nil, err
+	}
+	allocated, err := s.pg.GetEnvelopeAllocations(userID, since)
+	if err != nil {
+		return nil, err
+	}
+	spent, err := s.pg.GetSpentByCategory(userID, since)
+	if err != nil {
+		return nil, err
+	}
+	return buildEnvelopeSummary(since, income, allocated, spent), nil
+}
+
+func buildEnvelopeSummary(since time.Time, income float64, allocated, spent map[string]float64) *domain.EnvelopeSummary {
+	summary := &domain.EnvelopeSummary{Since: since, Income: round2(income), Unassigned: income}
+	for cat, amount := range allocated {
+		summary.Unassigned -= amount
+		summary.Envelopes = append(summary.Envelopes, &domain.Envelope{
+			Category:  cat,
+			Allocated: round2(amount),
+			Spent:     round2(spent[cat]),
+			Balance:   round2(amount - spent[cat]),
+		})
+	}
+	summary.Unassigned = round2(summary.Unassigned)
+	sort.Slice(summary.Envelopes, func(i, j int) bool { return summary.Envelopes[i].Category < summary.Envelopes[j].Category })
+	return summary
+}
+
+// envelopeBalances returns the balance of every funded envelope, or nil when
+// the user is not in envelope mode. Spending before the switch is not drawn
+// from envelopes, so since is returned along with the balances.
+func (s *LedgerService) envelopeBalances(userID int64) (map[string]float64, time.Time, error) {
+	mode, since := s.budgetMode(userID)
+	if mode != domain.BudgetModeEnvelopes {
+		return nil, since, nil
+	}
+	summary, err := s.envelopeSummary(userID, since)
+	if err != nil {
+		return nil, since, err
+	}
+	balances := make(map[string]float64, len(summary.Envelopes))
+	for _, e := range summary.Envelopes {
+		balances[e.Category] = e.Balance
+	}
+	return balances, since, nil
+}
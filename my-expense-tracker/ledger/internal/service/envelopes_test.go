@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBuildEnvelopeSummary(t *testing.T) {
+	since := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	allocated := map[string]float64{"Еда": 30000, "Кафе": 5000, "Транспорт": 0}
+	spent := map[string]float64{"Еда": 12500.5, "Кафе": 6000, "Подарки": 2000}
+
+	got := buildEnvelopeSummary(since, 100000, allocated, spent)
+	if got.Income != 100000 || got.Unassigned != 65000 {
+		t.Fatalf("income, unassigned = %v, %v, want 100000, 65000", got.Income, got.Unassigned)
+	}
+
+	want := []struct {
+		category string
+		balance  float64
+	}{
+		{"Еда", 17499.5},
+		{"Кафе", -1000},
+		{"Транспорт", 0},
+	}
+	if len(got.Envelopes) != len(want) {
+		t.Fatalf("got %d envelopes, want %d", len(got.Envelopes), len(want))
+	}
+	for i, w := range want {
+		if e := got.Envelopes[i]; e.Category != w.category || e.Balance != w.balance {
+			t.Errorf("envelope %d = %s %v, want %s %v", i, e.Category, e.Balance, w.category, w.balance)
+		}
+	}
+}
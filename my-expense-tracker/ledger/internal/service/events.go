@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	watchBlock = 15 * time.Second
+	// eventQueueSize is how many events may wait to be published; further
+	// events are dropped until the queue drains.
+	eventQueueSize = 1024
+)
+
+// budgetThresholds are the shares of a budget limit that trigger a
+// budget.threshold event when spending crosses them.
+var budgetThresholds = []float64{0.8, 1.0}
+
+type eventStore interface {
+	PublishEvent(ctx context.Context, userID int64, e *domain.LedgerEvent) error
+}
+
+type queuedEvent struct {
+	userID int64
+	event  *domain.LedgerEvent
+}
+
+// eventQueue publishes events one at a time in the order they were queued,
+// so a stream never shows a threshold before the transaction that crossed
+// it, without making requests wait for Redis.
+type eventQueue struct {
+	store  eventStore
+	events chan queuedEvent
+}
+
+func newEventQueue(store eventStore) *eventQueue {
+	return &eventQueue{store: store, events: make(chan queuedEvent, eventQueueSize)}
+}
+
+// push queues the event without waiting. When Redis falls behind and the
+// queue is full the event is dropped rather than holding up the write that
+// produced it.
+func (q *eventQueue) push(userID int64, e *domain.LedgerEvent) {
+	select {
+	case q.events <- queuedEvent{userID: userID, event: e}:
+	default:
+		log.Printf("Event queue full, dropping %s event for user %d", e.Type, userID)
+	}
+}
+
+// run publishes queued events until ctx is done.
+func (q *eventQueue) run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case qe := <-q.events:
+			if err := q.store.PublishEvent(ctx, qe.userID, qe.event); err != nil {
+				log.Printf("Redis error (PublishEvent): %v", err)
+			}
+		}
+	}
+}
+
+// RunEventPublisher publishes the events of all users in the order they
+// happened until ctx is done.
+func (s *LedgerService) RunEventPublisher(ctx context.Context) {
+	s.events.run(ctx)
+}
+
+func (s *LedgerService) publish(userID int64, e *domain.LedgerEvent) {
+	e.CreatedAt = time.Now()
+	s.events.push(userID, e)
+}
+
+func (s *LedgerService) publishTransaction(t *domain.Transaction) {
+	s.publish(t.UserID, &domain.LedgerEvent{
+		Type:          domain.EventTransactionCreated,
+		TransactionID: t.ID,
+		Amount:        t.Amount,
+		Category:      t.Category,
+		Description:   t.Description,
+	})
+}
+
+// publishThresholds emits a budget.threshold event for every threshold that
+// spending in category crossed going from before to after.
+func (s *LedgerService) publishThresholds(userID int64, category string, limit, before, after float64) {
+	if limit <= 0 {
+		return
+	}
+	for _, th := range budgetThresholds {
+		if before < limit*th && after >= limit*th {
+			s.publish(userID, &domain.LedgerEvent{
+				Type:        domain.EventBudgetThreshold,
+				Category:    category,
+				LimitAmount: limit,
+				Spent:       after,
+				Threshold:   th,
+			})
+		}
+	}
+}
+
+// WatchLedger sends the user's events to send until ctx is done. With an
+// empty lastID only events published from now on are sent; otherwise the
+// stream resumes right after lastID. When events after lastID may have been
+// trimmed from the stream, a stream.reset event is sent first so the client
+// reloads its state instead of missing them.
+func (s *LedgerService) WatchLedger(ctx context.Context, userID int64, lastID string, send func(*domain.LedgerEvent) error) error {
+	if lastID == "" {
+		var err error
+		if lastID, err = s.redis.LastEventID(ctx, userID); err != nil {
+			return err
+		}
+	} else {
+		firstID, err := s.redis.FirstEventID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if streamIDBefore(lastID, firstID) {
+			if err := send(&domain.LedgerEvent{ID: lastID, Type: domain.EventStreamReset, CreatedAt: time.Now()}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		events, err := s.redis.ReadEvents(ctx, userID, lastID, watchBlock)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if err := send(e); err != nil {
+				return err
+			}
+			lastID = e.ID
+		}
+	}
+}
+
+// streamIDBefore reports whether the Redis stream ID a is older than b. IDs
+// that cannot be parsed are never before another.
+func streamIDBefore(a, b string) bool {
+	am, as, ok := parseStreamID(a)
+	if !ok {
+		return false
+	}
+	bm, bs, ok := parseStreamID(b)
+	if !ok {
+		return false
+	}
+	return am < bm || (am == bm && as < bs)
+}
+
+func parseStreamID(id string) (uint64, uint64, bool) {
+	msPart, seqPart, ok := strings.Cut(id, "-")
+	if !ok {
+		return 0, 0, false
+	}
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	seq, err := strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return ms, seq, true
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+type recordingEventStore struct {
+	mu     sync.Mutex
+	events []*domain.LedgerEvent
+	done   chan struct{}
+	want   int
+}
+
+func (r *recordingEventStore) PublishEvent(ctx context.Context, userID int64, e *domain.LedgerEvent) error {
+	// A slow first write must not let later events overtake it.
+	if e.TransactionID == 1 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+	if len(r.events) == r.want {
+		close(r.done)
+	}
+	return nil
+}
+
+func TestEventQueueKeepsOrder(t *testing.T) {
+	store := &recordingEventStore{done: make(chan struct{}), want: 200}
+	q := newEventQueue(store)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go q.run(ctx)
+
+	for i := 1; i <= 100; i++ {
+		q.push(1, &domain.LedgerEvent{Type: domain.EventTransactionCreated, TransactionID: int64(i)})
+		q.push(1, &domain.LedgerEvent{Type: domain.EventBudgetThreshold, TransactionID: int64(i)})
+	}
+
+	select {
+	case <-store.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("events were not published")
+	}
+	for i, e := range store.events {
+		wantID, wantType := int64(i/2+1), domain.EventTransactionCreated
+		if i%2 == 1 {
+			wantType = domain.EventBudgetThreshold
+		}
+		if e.TransactionID != wantID || e.Type != wantType {
+			t.Fatalf("event %d = %s #%d, want %s #%d", i, e.Type, e.TransactionID, wantType, wantID)
+		}
+	}
+}
+
+func TestEventQueueDropsWhenFull(t *testing.T) {
+	q := newEventQueue(&recordingEventStore{done: make(chan struct{})})
+
+	// Nothing publishes, so the queue fills up and pushing must not block.
+	pushed := make(chan struct{})
+	go func() {
+		for i := 0; i < eventQueueSize+10; i++ {
+			q.push(1, &domain.LedgerEvent{Type: domain.EventTransactionCreated, TransactionID: int64(i)})
+		}
+		close(pushed)
+	}()
+
+	select {
+	case <-pushed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("push blocked on a full queue")
+	}
+	if len(q.events) != eventQueueSize {
+		t.Errorf("queued %d events, want %d", len(q.events), eventQueueSize)
+	}
+}
+
+func TestStreamIDBefore(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "1700000000000-0", b: "1700000000001-0", want: true},
+		{a: "1700000000000-2", b: "1700000000000-10", want: true},
+		{a: "1700000000000-10", b: "1700000000000-2", want: false},
+		{a: "1700000000000-0", b: "1700000000000-0", want: false},
+		{a: "0-0", b: "1700000000000-0", want: true},
+		{a: "1700000000000-0", b: "", want: false},
+		{a: "garbage", b: "1700000000000-0", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := streamIDBefore(tt.a, tt.b); got != tt.want {
+			t.Errorf("streamIDBefore(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// forecastHistoryMonths is how many full months before the current one are
+// used to learn daily spending patterns and recurring items.
+const forecastHistoryMonths = 3
+
+// recurringTolerance is the allowed deviation of a recurring item's amount
+// from its median across months.
+const recurringTolerance = 0.2
+
+func (s *LedgerService) GetForecast(ctx context.Context, userID int64) (*domain.Forecast, error) {
+	now := time.Now().In(s.userLocation(userID))
+	start := monthStart(now)
+	end := start.AddDate(0, 1, 0)
+
+	current, err := s.pg.ListTransactions(userID, start, now)
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.pg.ListTransactions(userID, start.AddDate(0, -forecastHistoryMonths, 0), start)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := s.GetBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildForecast(now, start, end, current, history, budgets), nil
+}
+
+// buildForecast projects spending to the end of [start, end) per category.
+// The projection is the spending so far, plus recurring items seen in every
+// history month but not yet this month, plus the average non-recurring spending
+// that historically happened in the remaining part of the month. Categories
+// without history fall back to the current month's run rate.
+func buildForecast(now, start, end time.Time, current, history []*domain.Transaction, budgets []*domain.Budget) *domain.Forecast {
+	elapsed := monthFraction(now, start, end)
+
+	recurring := findRecurring(history, start)
+	seen := make(map[string]bool)
+	for _, t := range current {
+		seen[recurringKey(t)] = true
+	}
+
+	byCat := make(map[string]*domain.CategoryForecast)
+	get := func(cat string) *domain.CategoryForecast {
+		f, ok := byCat[cat]
+		if !ok {
+			f = &domain.CategoryForecast{Category: cat}
+			byCat[cat] = f
+		}
+		return f
+	}
+
+	variableSpent := make(map[string]float64)
+	for _, t := range current {
+		get(t.Category).Spent += t.Amount
+		if _, ok := recurring[recurringKey(t)]; !ok {
+			variableSpent[t.Category] += t.Amount
+		}
+	}
+
+	historyRemaining := make(map[string]float64)
+	hasHistory := make(map[string]bool)
+	for _, t := range history {
+		hasHistory[t.Category] = true
+		if _, ok := recurring[recurringKey(t)]; ok {
+			continue
+		}
+		ms := monthStart(t.OccurredAt.In(start.Location()))
+		if monthFraction(t.OccurredAt, ms, ms.AddDate(0, 1, 0)) >= elapsed {
+			historyRemaining[t.Category] += t.Amount / forecastHistoryMonths
+		}
+	}
+
+	for key, amount := range recurring {
+		if seen[key] {
+			continue
+		}
+		get(strings.SplitN(key, "\x00", 2)[0]).RecurringPending += amount
+	}
+
+	for _, b := range budgets {
+		if !isCategoryScope(b.Category) {
+			continue
+		}
+		f := get(b.Category)
+		f.LimitAmount = b.EffectiveLimit
+		f.HasBudget = true
+	}
+
+	list := make([]*domain.CategoryForecast, 0, len(byCat))
+	for cat, f := range byCat {
+		remaining := historyRemaining[cat]
+		if !hasHistory[cat] && elapsed > 0 {
+			remaining = variableSpent[cat] / elapsed * (1 - elapsed)
+		}
+		f.Projected = round2(f.Spent + f.RecurringPending + remaining)
+		f.LikelyOverspend = f.HasBudget && f.Projected > f.LimitAmount
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Category < list[j].Category })
+
+	return &domain.Forecast{
+		PeriodStart: start,
+		PeriodEnd:   end.AddDate(0, 0, -1),
+		DaysElapsed: now.Day(),
+		DaysTotal:   end.AddDate(0, 0, -1).Day(),
+		Categories:  list,
+	}
+}
+
+// findRecurring returns the median amount of every category/description pair
+// that occurred with a stable amount in each history month before start.
+func findRecurring(history []*domain.Transaction, start time.Time) map[string]float64 {
+	amounts := make(map[string][]float64)
+	months := make(map[string]map[time.Time]bool)
+	for _, t := range history {
+		if strings.TrimSpace(t.Description) == "" {
+			continue
+		}
+		key := recurringKey(t)
+		amounts[key] = append(amounts[key], t.Amount)
+		if months[key] == nil {
+			months[key] = make(map[time.Time]bool)
+		}
+		months[key][monthStart(t.OccurredAt.In(start.Location()))] = true
+	}
+
+	result := make(map[string]float64)
+	for key, list := range amounts {
+		if len(months[key]) < forecastHistoryMonths || len(list) != len(months[key]) {
+			continue
+		}
+		m := median(list)
+		stable := true
+		for _, a := range list {
+			if math.Abs(a-m) > m*recurringTolerance {
+				stable = false
+				break
+			}
+		}
+		if stable {
+			result[key] = m
+		}
+	}
+	return result
+}
+
+func recurringKey(t *domain.Transaction) string {
+	return t.Category + "\x00" + strings.ToLower(strings.TrimSpace(t.Description))
+}
+
+func monthFraction(t, start, end time.Time) float64 {
+	return float64(t.Sub(start)) / float64(end.Sub(start))
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
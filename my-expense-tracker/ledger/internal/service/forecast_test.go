@@ -0,0 +1,105 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestBuildForecast(t *testing.T) {
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2026, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	expense := func(category, description string, amount float64, occurredAt time.Time) *domain.Transaction {
+		return &domain.Transaction{Category: category, Description: description, Amount: amount, OccurredAt: occurredAt}
+	}
+	rent := []*domain.Transaction{
+		expense("Жильё", "Аренда", 30000, time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)),
+		expense("Жильё", "Аренда", 30000, time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)),
+		expense("Жильё", "Аренда", 30000, at(time.January, 1, 10)),
+	}
+
+	tests := []struct {
+		name            string
+		now             time.Time
+		current         []*domain.Transaction
+		history         []*domain.Transaction
+		budgets         []*domain.Budget
+		wantDaysElapsed int
+		wantDaysTotal   int
+		wantProjected   map[string]float64
+		wantOverspend   map[string]bool
+	}{
+		{
+			name:            "Start of month has no run rate yet",
+			now:             at(time.February, 1, 0),
+			current:         []*domain.Transaction{expense("Еда", "", 100, at(time.February, 1, 0))},
+			wantDaysElapsed: 1,
+			wantDaysTotal:   28,
+			wantProjected:   map[string]float64{"Еда": 100},
+		},
+		{
+			name:            "Halfway through a 28-day month",
+			now:             at(time.February, 15, 0),
+			current:         []*domain.Transaction{expense("Еда", "", 1400, at(time.February, 3, 12))},
+			wantDaysElapsed: 15,
+			wantDaysTotal:   28,
+			wantProjected:   map[string]float64{"Еда": 2800},
+		},
+		{
+			name:            "End of a 31-day month",
+			now:             at(time.January, 31, 12),
+			current:         []*domain.Transaction{expense("Еда", "", 3050, at(time.January, 10, 12))},
+			budgets:         []*domain.Budget{{Category: "Еда", EffectiveLimit: 3000}},
+			wantDaysElapsed: 31,
+			wantDaysTotal:   31,
+			wantProjected:   map[string]float64{"Еда": 3100},
+			wantOverspend:   map[string]bool{"Еда": true},
+		},
+		{
+			name:            "Recurring item still to come",
+			now:             at(time.February, 15, 0),
+			history:         rent,
+			budgets:         []*domain.Budget{{Category: "Жильё", EffectiveLimit: 35000}},
+			wantDaysElapsed: 15,
+			wantDaysTotal:   28,
+			wantProjected:   map[string]float64{"Жильё": 30000},
+		},
+		{
+			name:            "No spending",
+			now:             at(time.March, 10, 0),
+			budgets:         []*domain.Budget{{Category: "Еда", EffectiveLimit: 3000}, {Category: domain.ScopeAll, EffectiveLimit: 9000}},
+			wantDaysElapsed: 10,
+			wantDaysTotal:   31,
+			wantProjected:   map[string]float64{"Еда": 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := monthStart(tt.now)
+			f := buildForecast(tt.now, start, start.AddDate(0, 1, 0), tt.current, tt.history, tt.budgets)
+
+			if f.DaysElapsed != tt.wantDaysElapsed || f.DaysTotal != tt.wantDaysTotal {
+				t.Errorf("days = %d/%d, want %d/%d", f.DaysElapsed, f.DaysTotal, tt.wantDaysElapsed, tt.wantDaysTotal)
+			}
+			if len(f.Categories) != len(tt.wantProjected) {
+				t.Fatalf("got %d categories, want %d", len(f.Categories), len(tt.wantProjected))
+			}
+			for _, c := range f.Categories {
+				want, ok := tt.wantProjected[c.Category]
+				if !ok {
+					t.Errorf("unexpected category %q", c.Category)
+					continue
+				}
+				if c.Projected != want {
+					t.Errorf("%s: Projected = %v, want %v", c.Category, c.Projected, want)
+				}
+				if c.LikelyOverspend != tt.wantOverspend[c.Category] {
+					t.Errorf("%s: LikelyOverspend = %v, want %v", c.Category, c.LikelyOverspend, tt.wantOverspend[c.Category])
+				}
+			}
+		})
+	}
+}
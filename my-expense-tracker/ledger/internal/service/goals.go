@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// averageMonth is the mean length of a calendar month, used to turn a
+// contribution rate into a completion date.
+const averageMonth = 730*time.Hour + 30*time.Minute
+
+func (s *LedgerService) CreateGoal(ctx context.Context, userID int64, name string, target float64, deadline string) (*domain.Goal, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("goal name cannot be empty")
+	}
+	if len(name) > 50 {
+		return nil, errors.New("goal name too long")
+	}
+	// Contributions are recorded under the goal name as their category.
+	if err := validateCategory(name); err != nil {
+		return nil, err
+	}
+	if target <= 0 {
+		return nil, errors.New("target amount must be positive")
+	}
+
+	loc := s.userLocation(userID)
+	d, err := time.ParseInLocation(dateLayout, deadline, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid deadline %q, expected YYYY-MM-DD", deadline)
+	}
+	if d.Before(dayStart(time.Now().In(loc))) {
+		return nil, errors.New("deadline is in the past")
+	}
+
+	g := &domain.Goal{UserID: userID, Name: name, TargetAmount: target, Deadline: d}
+	if err := s.pg.CreateGoal(g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// Contribute records a contribution to a goal as a savings entry in the
+// ledger. Savings are not spending, so budgets and anomaly checks do not apply.
+func (s *LedgerService) Contribute(ctx context.Context, userID, goalID int64, amount float64, description string, occurredAt time.Time) *domain.TransactionResult {
+	g, err := s.pg.GetGoal(userID, goalID)
+	if err != nil {
+		log.Printf("DB error (GetGoal): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if g == nil {
+		return &domain.TransactionResult{Success: false, Message: "Goal not found"}
+	}
+
+	t := &domain.Transaction{
+		UserID:      userID,
+		Amount:      amount,
+		Category:    g.Name,
+		Description: description,
+		OccurredAt:  occurredAt,
+		Kind:        domain.KindSaving,
+		GoalID:      g.ID,
+	}
+	return s.recordEntry(t)
+}
+
+func (s *LedgerService) GetGoals(ctx context.Context, userID int64) ([]*domain.GoalProgress, error) {
+	goals, err := s.pg.ListGoals(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().In(s.userLocation(userID))
+	list := make([]*domain.GoalProgress, 0, len(goals))
+	for _, g := range goals {
+		contributions, err := s.pg.ListContributions(userID, g.ID)
+		if err != nil {
+			return nil, err
+		}
+		g.Deadline = dateIn(g.Deadline, now.Location())
+		list = append(list, buildGoalProgress(g, contributions, now))
+	}
+	return list, nil
+}
+
+// buildGoalProgress sums the contributions to g and works out what is still
+// needed. The required monthly amount spreads the remainder over the calendar
+// months left until the deadline, the current one included. The projected
+// completion extends the average monthly contribution since the first one; it
+// stays zero while nothing has been saved.
+func buildGoalProgress(g *domain.Goal, contributions []*domain.Transaction, now time.Time) *domain.GoalProgress {
+	p := &domain.GoalProgress{Goal: g}
+	for _, t := range contributions {
+		p.Saved += t.Amount
+		if !p.Completed && p.Saved >= g.TargetAmount {
+			p.Completed = true
+			p.ProjectedCompletion = t.OccurredAt
+		}
+	}
+	p.Saved = round2(p.Saved)
+	p.Remaining = round2(max(g.TargetAmount-p.Saved, 0))
+	p.Percent = round2(min(p.Saved/g.TargetAmount*100, 100))
+
+	deadlineEnd := g.Deadline.AddDate(0, 0, 1)
+	if now.Before(deadlineEnd) {
+		p.MonthsLeft = (g.Deadline.Year()-now.Year())*12 + int(g.Deadline.Month()-now.Month()) + 1
+		p.RequiredMonthly = round2(p.Remaining / float64(p.MonthsLeft))
+	} else {
+		p.RequiredMonthly = p.Remaining
+	}
+
+	if len(contributions) > 0 {
+		months := max(float64(now.Sub(contributions[0].OccurredAt))/float64(averageMonth), 1)
+		p.AverageMonthly = round2(p.Saved / months)
+	}
+	if !p.Completed && p.AverageMonthly > 0 {
+		p.ProjectedCompletion = now.Add(time.Duration(p.Remaining / p.AverageMonthly * float64(averageMonth)))
+	}
+	p.OnTrack = !p.ProjectedCompletion.IsZero() && p.ProjectedCompletion.Before(deadlineEnd)
+	return p
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestBuildGoalProgress(t *testing.T) {
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	saved := func(at time.Time, amount float64) *domain.Transaction {
+		return &domain.Transaction{Kind: domain.KindSaving, Amount: amount, OccurredAt: at}
+	}
+
+	tests := []struct {
+		name          string
+		goal          *domain.Goal
+		contributions []*domain.Transaction
+		want          domain.GoalProgress
+	}{
+		{
+			name: "Deadline passed",
+			goal: &domain.Goal{TargetAmount: 100000, Deadline: date(2026, 5, 31)},
+			contributions: []*domain.Transaction{
+				saved(now.Add(-4*averageMonth), 20000),
+				saved(now.Add(-2*averageMonth), 20000),
+			},
+			want: domain.GoalProgress{
+				Saved:               40000,
+				Remaining:           60000,
+				Percent:             40,
+				RequiredMonthly:     60000,
+				AverageMonthly:      10000,
+				ProjectedCompletion: now.Add(6 * averageMonth),
+			},
+		},
+		{
+			name: "Goal already reached",
+			goal: &domain.Goal{TargetAmount: 50000, Deadline: date(2026, 12, 31)},
+			contributions: []*domain.Transaction{
+				saved(date(2026, 2, 10), 30000),
+				saved(date(2026, 4, 20), 25000),
+			},
+			want: domain.GoalProgress{
+				Saved:               55000,
+				Percent:             100,
+				MonthsLeft:          7,
+				AverageMonthly:      round2(55000 / (float64(now.Sub(date(2026, 2, 10))) / float64(averageMonth))),
+				ProjectedCompletion: date(2026, 4, 20),
+				OnTrack:             true,
+				Completed:           true,
+			},
+		},
+		{
+			name: "Deadline in the current month",
+			goal: &domain.Goal{TargetAmount: 30000, Deadline: date(2026, 6, 30)},
+			contributions: []*domain.Transaction{
+				saved(date(2026, 6, 1), 10000),
+			},
+			want: domain.GoalProgress{
+				Saved:               10000,
+				Remaining:           20000,
+				Percent:             33.33,
+				MonthsLeft:          1,
+				RequiredMonthly:     20000,
+				AverageMonthly:      10000,
+				ProjectedCompletion: now.Add(2 * averageMonth),
+			},
+		},
+		{
+			name: "Deadline today",
+			goal: &domain.Goal{TargetAmount: 30000, Deadline: date(2026, 6, 15)},
+			want: domain.GoalProgress{
+				Remaining:       30000,
+				MonthsLeft:      1,
+				RequiredMonthly: 30000,
+			},
+		},
+		{
+			name: "On track",
+			goal: &domain.Goal{TargetAmount: 60000, Deadline: date(2026, 12, 31)},
+			contributions: []*domain.Transaction{
+				saved(now.Add(-2*averageMonth), 20000),
+			},
+			want: domain.GoalProgress{
+				Saved:               20000,
+				Remaining:           40000,
+				Percent:             33.33,
+				MonthsLeft:          7,
+				RequiredMonthly:     5714.29,
+				AverageMonthly:      10000,
+				ProjectedCompletion: now.Add(4 * averageMonth),
+				OnTrack:             true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildGoalProgress(tt.goal, tt.contributions, now)
+			tt.want.Goal = tt.goal
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("buildGoalProgress() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	idempotencyTTL       = 24 * time.Hour
+	idempotencyLockTTL   = 30 * time.Second
+	idempotencyWait      = 10 * time.Second
+	idempotencyPoll      = 100 * time.Millisecond
+	idempotencyKeyMaxLen = 128
+)
+
+// idempotencyStore keeps idempotency keys with the hash of the request that
+// claimed them and, once it finished, its result.
+type idempotencyStore interface {
+	AcquireIdempotencyKey(ctx context.Context, userID int64, key, hash string, ttl time.Duration) (bool, error)
+	GetIdempotentResult(ctx context.Context, userID int64, key string) (string, *domain.TransactionResult, error)
+	SetIdempotentResult(ctx context.Context, userID int64, key, hash string, res *domain.TransactionResult, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error
+}
+
+// createIdempotent runs createTransaction at most once per user and key for
+// the request body.
+func (s *LedgerService) createIdempotent(ctx context.Context, t *domain.Transaction, key string, body []byte) *domain.TransactionResult {
+	return runIdempotent(ctx, s.redis, t.UserID, key, body, idempotencyWait, func() (*domain.TransactionResult, error) {
+		return s.createTransaction(ctx, t)
+	})
+}
+
+// runIdempotent runs create at most once per user and key. The first request
+// claims the key for a short while; concurrent and later requests with the
+// same key and body wait up to wait for its outcome and get the original
+// response back, which is kept for a day. Reusing a key for a different body
+// is rejected. Retryable failures release the key so the client can try
+// again; when the store is unavailable nothing is created, as the request
+// could not be deduplicated.
+func runIdempotent(ctx context.Context, store idempotencyStore, userID int64, key string, body []byte, wait time.Duration, create func() (*domain.TransactionResult, error)) *domain.TransactionResult {
+	if len(key) > idempotencyKeyMaxLen {
+		return &domain.TransactionResult{Success: false, Message: "Idempotency key is too long"}
+	}
+	sum := sha256.Sum256(body)
+	hash := hex.EncodeToString(sum[:])
+
+	deadline := time.Now().Add(wait)
+	for {
+		acquired, err := store.AcquireIdempotencyKey(ctx, userID, key, hash, idempotencyLockTTL)
+		if err != nil {
+			log.Printf("Redis error (AcquireIdempotencyKey): %v", err)
+			return &domain.TransactionResult{Success: false, Message: "Idempotency check unavailable, try again"}
+		}
+		if acquired {
+			break
+		}
+
+		claimed, res, err := store.GetIdempotentResult(ctx, userID, key)
+		if err != nil {
+			log.Printf("Redis error (GetIdempotentResult): %v", err)
+		} else if claimed != "" && claimed != hash {
+			return &domain.TransactionResult{Success: false, Message: "Idempotency key was already used for a different request"}
+		} else if res != nil {
+			return res
+		}
+
+		if time.Now().After(deadline) {
+			return &domain.TransactionResult{Success: false, Message: "Request with this idempotency key is still in progress"}
+		}
+		select {
+		case <-ctx.Done():
+			return &domain.TransactionResult{Success: false, Message: ctx.Err().Error()}
+		case <-time.After(idempotencyPoll):
+		}
+	}
+
+	res, err := create()
+	if err != nil {
+		log.Printf("DB error (CreateTransaction): %v", err)
+		if err := store.ReleaseIdempotencyKey(context.Background(), userID, key); err != nil {
+			log.Printf("Redis error (ReleaseIdempotencyKey): %v", err)
+		}
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+
+	if err := store.SetIdempotentResult(context.Background(), userID, key, hash, res, idempotencyTTL); err != nil {
+		log.Printf("Redis error (SetIdempotentResult): %v", err)
+	}
+	return res
+}
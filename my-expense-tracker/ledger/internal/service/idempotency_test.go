@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+type memoryIdempotencyStore struct {
+	mu      sync.Mutex
+	hashes  map[string]string
+	results map[string]*domain.TransactionResult
+	err     error
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{hashes: make(map[string]string), results: make(map[string]*domain.TransactionResult)}
+}
+
+func (m *memoryIdempotencyStore) AcquireIdempotencyKey(ctx context.Context, userID int64, key, hash string, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return false, m.err
+	}
+	if _, ok := m.hashes[key]; ok {
+		return false, nil
+	}
+	m.hashes[key] = hash
+	return true, nil
+}
+
+func (m *memoryIdempotencyStore) GetIdempotentResult(ctx context.Context, userID int64, key string) (string, *domain.TransactionResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.hashes[key], m.results[key], nil
+}
+
+func (m *memoryIdempotencyStore) SetIdempotentResult(ctx context.Context, userID int64, key, hash string, res *domain.TransactionResult, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hashes[key], m.results[key] = hash, res
+	return nil
+}
+
+func (m *memoryIdempotencyStore) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.hashes, key)
+	delete(m.results, key)
+	return nil
+}
+
+func TestRunIdempotent(t *testing.T) {
+	ctx := context.Background()
+	body := []byte(`{"amount":350,"category":"Кафе"}`)
+	wait := 50 * time.Millisecond
+
+	t.Run("Replay returns the original result", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		calls := 0
+		create := func() (*domain.TransactionResult, error) {
+			calls++
+			return &domain.TransactionResult{Success: true, Message: "Saved", TransactionID: 42}, nil
+		}
+
+		first := runIdempotent(ctx, store, 1, "k1", body, wait, create)
+		second := runIdempotent(ctx, store, 1, "k1", body, wait, create)
+		if calls != 1 {
+			t.Fatalf("create called %d times, want 1", calls)
+		}
+		if !second.Success || second.TransactionID != first.TransactionID {
+			t.Errorf("replay = %+v, want %+v", second, first)
+		}
+	})
+
+	t.Run("Request in progress", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		started, release := make(chan struct{}), make(chan struct{})
+		done := make(chan *domain.TransactionResult)
+		go func() {
+			done <- runIdempotent(ctx, store, 1, "k2", body, wait, func() (*domain.TransactionResult, error) {
+				close(started)
+				<-release
+				return &domain.TransactionResult{Success: true, TransactionID: 7}, nil
+			})
+		}()
+		<-started
+
+		res := runIdempotent(ctx, store, 1, "k2", body, wait, func() (*domain.TransactionResult, error) {
+			t.Error("create called while the first request is in progress")
+			return nil, nil
+		})
+		if res.Success || !strings.Contains(res.Message, "in progress") {
+			t.Errorf("concurrent request = %+v, want in progress", res)
+		}
+
+		close(release)
+		if res := <-done; !res.Success || res.TransactionID != 7 {
+			t.Errorf("first request = %+v", res)
+		}
+	})
+
+	t.Run("Key reused for a different body", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		create := func() (*domain.TransactionResult, error) {
+			return &domain.TransactionResult{Success: true, TransactionID: 9}, nil
+		}
+		runIdempotent(ctx, store, 1, "k3", body, wait, create)
+
+		res := runIdempotent(ctx, store, 1, "k3", []byte(`{"amount":3500,"category":"Кафе"}`), wait, create)
+		if res.Success || !strings.Contains(res.Message, "different request") {
+			t.Errorf("mismatched body = %+v, want rejected", res)
+		}
+	})
+
+	t.Run("Failure releases the key", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		res := runIdempotent(ctx, store, 1, "k4", body, wait, func() (*domain.TransactionResult, error) {
+			return nil, errors.New("connection reset")
+		})
+		if res.Success {
+			t.Fatalf("failed create = %+v, want failure", res)
+		}
+		res = runIdempotent(ctx, store, 1, "k4", body, wait, func() (*domain.TransactionResult, error) {
+			return &domain.TransactionResult{Success: true, TransactionID: 11}, nil
+		})
+		if !res.Success || res.TransactionID != 11 {
+			t.Errorf("retry = %+v, want saved", res)
+		}
+	})
+
+	t.Run("Store unavailable", func(t *testing.T) {
+		store := newMemoryIdempotencyStore()
+		store.err = errors.New("redis: connection refused")
+		res := runIdempotent(ctx, store, 1, "k5", body, wait, func() (*domain.TransactionResult, error) {
+			t.Error("create called without the key")
+			return nil, nil
+		})
+		if res.Success {
+			t.Errorf("result = %+v, want failure", res)
+		}
+	})
+}
@@ -2,34 +2,73 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
 	"github.com/yuramishin/expense-tracker/ledger/internal/repository"
 )
 
 type LedgerService struct {
-	pg    *repository.PostgresRepo
-	redis *repository.RedisRepo
+	pg          *repository.PostgresRepo
+	redis       *repository.RedisRepo
+	blobs       repository.BlobStore
+	defaultZone *time.Location
+	events      *eventQueue
 }
 
-func NewLedgerService(pg *repository.PostgresRepo, redis *repository.RedisRepo) *LedgerService {
-	return &LedgerService{pg: pg, redis: redis}
+func NewLedgerService(pg *repository.PostgresRepo, redis *repository.RedisRepo, blobs repository.BlobStore, defaultZone *time.Location) *LedgerService {
+	return &LedgerService{pg: pg, redis: redis, blobs: blobs, defaultZone: defaultZone, events: newEventQueue(redis)}
 }
 
-func (s *LedgerService) CreateTransaction(ctx context.Context, t *domain.Transaction) (bool, string) {
-	budget, err := s.pg.GetBudget(t.UserID, t.Category)
-	if err == nil && budget != nil {
-		spent, _ := s.pg.GetTotalSpent(t.UserID, t.Category)
-		if spent+t.Amount > budget.LimitAmount {
-			msg := fmt.Sprintf("Budget exceeded! Limit: %.0f, Spent: %.0f", budget.LimitAmount, spent)
-			return false, msg
-		}
+// CreateTransaction records an expense. With an idempotency key the request,
+// identified by its body, is recorded only once.
+func (s *LedgerService) CreateTransaction(ctx context.Context, t *domain.Transaction, idempotencyKey string, body []byte) *domain.TransactionResult {
+	if idempotencyKey != "" {
+		return s.createIdempotent(ctx, t, idempotencyKey, body)
+	}
+
+	res, err := s.createTransaction(ctx, t)
+	if err != nil {
+		log.Printf("DB error (CreateTransaction): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	return res
+}
+
+// createTransaction returns an error only for failures that are worth
+// retrying; a rejected budget check is a regular result.
+func (s *LedgerService) createTransaction(ctx context.Context, t *domain.Transaction) (*domain.TransactionResult, error) {
+	if t.OccurredAt.IsZero() {
+		t.OccurredAt = time.Now()
+	}
+	if err := validateTransaction(t); err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}, nil
 	}
 
+	checks, rejected, err := s.checkSpending(t, nil)
+	if err != nil {
+		return nil, err
+	}
+	if rejected != "" {
+		return &domain.TransactionResult{Success: false, Message: rejected}, nil
+	}
+
+	s.resolveMerchants(t.UserID, []*domain.Transaction{t})
+	score, reasons := s.detectAnomaly(t)
+
 	if err := s.pg.CreateTransaction(t); err != nil {
-		return false, "DB Error"
+		return nil, err
+	}
+	s.reopenBudgetPeriods(t.UserID, t.OccurredAt)
+
+	if len(reasons) > 0 {
+		a := &domain.Anomaly{UserID: t.UserID, TransactionID: t.ID, Score: score, Reasons: reasons}
+		if err := s.pg.CreateAnomaly(a); err != nil {
+			log.Printf("DB error (CreateAnomaly): %v", err)
+		}
 	}
 
 	go func() {
@@ -38,7 +77,107 @@ func (s *LedgerService) CreateTransaction(ctx context.Context, t *domain.Transac
 		}
 	}()
 
-	return true, "Saved"
+	s.publishTransaction(t)
+	s.publishChecks(t.UserID, checks)
+
+	return &domain.TransactionResult{
+		Success:        true,
+		Message:        "Saved",
+		TransactionID:  t.ID,
+		AnomalyScore:   score,
+		AnomalyReasons: reasons,
+	}, nil
+}
+
+// checkSpending runs the envelope or budget checks for the expense t. When t
+// is an edit of the expense prev, the amount of prev is taken out of the
+// envelope or budget it already counts towards, and the edit is rejected only
+// when it adds spending that goes over the limit. A non-empty message rejects
+// t; otherwise the budgets it was checked against are returned.
+func (s *LedgerService) checkSpending(t, prev *domain.Transaction) ([]budgetCheck, string, error) {
+	if t.Reimbursed {
+		// Reimbursed expenses are no longer personal spending.
+		return nil, "", nil
+	}
+
+	envelopes, since, err := s.envelopeBalances(t.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+	if envelopes != nil {
+		// Envelopes replace the monthly limits; categories that were never
+		// funded are not checked.
+		balance, ok := envelopes[t.Category]
+		if !ok || t.OccurredAt.Before(since) {
+			return nil, "", nil
+		}
+		added := t.Amount
+		if prev != nil && prev.Category == t.Category && !prev.OccurredAt.Before(since) {
+			added -= prev.Amount
+		}
+		if added > 0 && added > balance {
+			return nil, fmt.Sprintf("Envelope exceeded! Available: %.0f", balance+t.Amount-added), nil
+		}
+		return nil, "", nil
+	}
+
+	covering, err := s.coveringBudgets(t.UserID)
+	if err != nil {
+		log.Printf("DB error (coveringBudgets): %v", err)
+		return nil, "", nil
+	}
+	loc := s.userLocation(t.UserID)
+	start := monthStart(t.OccurredAt.In(loc))
+	prevScopes := make(map[string]bool)
+	if prev != nil && monthStart(prev.OccurredAt.In(loc)).Equal(start) {
+		for _, b := range covering(prev) {
+			prevScopes[b.Category] = true
+		}
+	}
+
+	// Every budget the transaction falls under must have room for it.
+	var checks []budgetCheck
+	for _, b := range covering(t) {
+		limit, ok := s.budgetLimit(t.UserID, b, start)
+		if !ok {
+			continue
+		}
+		spent, _ := s.pg.GetTotalSpent(t.UserID, b.Category, start, start.AddDate(0, 1, 0))
+		added := t.Amount
+		if prevScopes[b.Category] {
+			added -= prev.Amount
+		}
+		if added > 0 && spent+added > limit {
+			return nil, budgetExceeded(b.Category, limit, spent+t.Amount-added), nil
+		}
+		checks = append(checks, budgetCheck{scope: b.Category, limit: limit, before: spent, after: spent + added})
+	}
+	return checks, "", nil
+}
+
+// publishChecks publishes the budget thresholds a change crossed.
+func (s *LedgerService) publishChecks(userID int64, checks []budgetCheck) {
+	for _, c := range checks {
+		s.publishThresholds(userID, c.scope, c.limit, c.before, c.after)
+	}
+}
+
+// recordEntry saves a ledger entry that is not an expense, such as a savings
+// contribution, without the budget and anomaly checks.
+func (s *LedgerService) recordEntry(t *domain.Transaction) *domain.TransactionResult {
+	if t.OccurredAt.IsZero() {
+		t.OccurredAt = time.Now()
+	}
+	if err := validateTransaction(t); err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+	if err := s.pg.CreateTransaction(t); err != nil {
+		log.Printf("DB error (CreateTransaction): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+
+	s.publishTransaction(t)
+	return &domain.TransactionResult{Success: true, Message: "Saved", TransactionID: t.ID}
 }
 
 func (s *LedgerService) GetReport(ctx context.Context, userID int64) (map[string]float64, error) {
@@ -63,10 +202,37 @@ func (s *LedgerService) GetReport(ctx context.Context, userID int64) (map[string
 	return data, nil
 }
 
-func (s *LedgerService) SetBudget(ctx context.Context, userID int64, category string, limit float64) error {
-	if err := s.pg.SetBudget(userID, category, limit); err != nil {
+// SetBudget changes the budget's limit from effectiveFrom, a "YYYY-MM-DD" date
+// that defaults to today and may lie in the future. Months use the latest
+// limit effective on or before their last day, so a change applies to the
+// whole month it falls in. category may also be any other budget scope.
+func (s *LedgerService) SetBudget(ctx context.Context, userID int64, category string, limit float64, effectiveFrom, rollover string, rolloverCap float64) error {
+	category, err := normalizeScope(category)
+	if err != nil {
 		return err
 	}
+	switch rollover {
+	case "", domain.RolloverReset, domain.RolloverUnspent, domain.RolloverOverspent, domain.RolloverBoth:
+	default:
+		return fmt.Errorf("unknown rollover policy %q", rollover)
+	}
+	if rolloverCap < 0 {
+		return errors.New("rollover cap cannot be negative")
+	}
+
+	today := dayStart(time.Now().In(s.userLocation(userID)))
+	from := today
+	if effectiveFrom != "" {
+		if from, err = time.ParseInLocation(dateLayout, effectiveFrom, today.Location()); err != nil {
+			return fmt.Errorf("invalid effective date %q, expected YYYY-MM-DD", effectiveFrom)
+		}
+	}
+
+	if err := s.pg.SetBudget(userID, category, limit, from, today, rollover, rolloverCap); err != nil {
+		return err
+	}
+
+	s.publish(userID, &domain.LedgerEvent{Type: domain.EventBudgetChanged, Category: category, LimitAmount: limit})
 
 	go func() {
 		if err := s.redis.InvalidateBudgets(context.Background(), userID); err != nil {
@@ -88,6 +254,12 @@ func (s *LedgerService) GetBudgets(ctx context.Context, userID int64) ([]*domain
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now().In(s.userLocation(userID))
+	for _, b := range list {
+		if _, err := s.applyRollover(userID, b, now); err != nil {
+			return nil, err
+		}
+	}
 
 	go func() {
 		if err := s.redis.SetBudgets(context.Background(), userID, list); err != nil {
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	topMerchantsDefault = 10
+	topMerchantsMax     = 100
+)
+
+// merchantNoise are descriptor words that say nothing about the merchant:
+// cities, countries, legal forms and web suffixes added by banks and
+// payment processors.
+var merchantNoise = map[string]bool{
+	"moscow": true, "moskva": true, "msk": true, "spb": true, "st": true, "petersburg": true, "peterburg": true,
+	"москва": true, "мск": true, "спб": true, "санкт": true, "петербург": true,
+	"rus": true, "ru": true, "russia": true, "рф": true, "россия": true,
+	"ooo": true, "ооо": true, "ип": true, "ip": true, "ао": true, "зао": true, "пао": true, "llc": true, "ltd": true, "inc": true,
+	"www": true, "com": true,
+}
+
+// normalizeDescriptor reduces a bank descriptor or description to lowercase
+// words without punctuation, numbers and noise, so that "YANDEX*TAXI 4521
+// MOSCOW" and "Yandex Taxi" both become "yandex taxi".
+func normalizeDescriptor(s string) string {
+	s = strings.ReplaceAll(strings.ToLower(s), "ё", "е")
+	words := strings.FieldsFunc(s, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+
+	var out []string
+	for _, w := range words {
+		if utf8.RuneCountInString(w) < 2 || merchantNoise[w] || strings.IndexFunc(w, unicode.IsLetter) < 0 {
+			continue
+		}
+		out = append(out, w)
+	}
+	return strings.Join(out, " ")
+}
+
+// matchMerchant returns the merchant whose alias occurs as whole words in the
+// normalized descriptor key. Longer aliases win over shorter ones, and of
+// equally long aliases the one added first.
+func matchMerchant(key string, aliases []*domain.MerchantAlias) int64 {
+	var best *domain.MerchantAlias
+	for _, a := range aliases {
+		if !strings.Contains(" "+key+" ", " "+a.Pattern+" ") {
+			continue
+		}
+		if best == nil || len(a.Pattern) > len(best.Pattern) {
+			best = a
+		}
+	}
+	if best == nil {
+		return 0
+	}
+	return best.MerchantID
+}
+
+// merchantOf returns the merchant a transaction description matches.
+func merchantOf(description string, aliases []*domain.MerchantAlias) int64 {
+	return matchMerchant(normalizeDescriptor(description), aliases)
+}
+
+// resolveMerchants links each transaction to the merchant whose alias its
+// description matches. Descriptions no alias matches stay unlinked; merchants
+// are only created by the user. Errors are logged and leave the transaction
+// unlinked.
+func (s *LedgerService) resolveMerchants(userID int64, list []*domain.Transaction) {
+	var aliases []*domain.MerchantAlias
+	loaded := false
+	for _, t := range list {
+		t.MerchantID = 0
+		key := normalizeDescriptor(t.Description)
+		if key == "" {
+			continue
+		}
+		if !loaded {
+			var err error
+			if aliases, err = s.pg.ListMerchantAliases(userID); err != nil {
+				log.Printf("DB error (ListMerchantAliases): %v", err)
+				return
+			}
+			loaded = true
+		}
+		t.MerchantID = matchMerchant(key, aliases)
+	}
+}
+
+// CreateMerchant adds a merchant with the given alias patterns. The name is
+// an alias as well. Existing and new transactions are linked to it when their
+// description matches one of the aliases by words.
+func (s *LedgerService) CreateMerchant(ctx context.Context, userID int64, name string, aliases []string) (*domain.Merchant, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("merchant name cannot be empty")
+	}
+	if len(name) > 100 {
+		return nil, errors.New("merchant name too long")
+	}
+
+	aliases = append([]string{name}, aliases...)
+	patterns := []string{}
+	for _, a := range aliases {
+		p := normalizeDescriptor(a)
+		if p == "" {
+			return nil, fmt.Errorf("alias %q has no words to match", a)
+		}
+		patterns = append(patterns, p)
+	}
+
+	m := &domain.Merchant{UserID: userID, Name: name}
+	if err := s.pg.CreateMerchant(m, patterns, merchantOf); err != nil {
+		return nil, err
+	}
+	m.Aliases = patterns
+	return m, nil
+}
+
+func (s *LedgerService) AddMerchantAlias(ctx context.Context, userID, merchantID int64, pattern string) error {
+	p := normalizeDescriptor(pattern)
+	if p == "" {
+		return fmt.Errorf("alias %q has no words to match", pattern)
+	}
+	if err := s.pg.AddMerchantAlias(userID, merchantID, p, merchantOf); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *LedgerService) ListMerchants(ctx context.Context, userID int64) ([]*domain.Merchant, error) {
+	return s.pg.ListMerchants(userID)
+}
+
+// GetTopMerchants ranks merchants by spending over an inclusive date range.
+func (s *LedgerService) GetTopMerchants(ctx context.Context, userID int64, fromValue, toValue string, limit int) (*domain.MerchantReport, error) {
+	from, to, err := parseDateRange(fromValue, toValue, s.userLocation(userID))
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = topMerchantsDefault
+	}
+
+	list, err := s.pg.GetMerchantStats(userID, from, to.AddDate(0, 0, 1), min(limit, topMerchantsMax))
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range list {
+		m.Total = round2(m.Total)
+		m.Average = round2(m.Average)
+	}
+	return &domain.MerchantReport{From: from, To: to, Merchants: list}, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestNormalizeDescriptor(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"YANDEX*TAXI 4521 MOSCOW", "yandex taxi"},
+		{"Yandex Taxi", "yandex taxi"},
+		{"ООО \"Пятёрочка\" 12345 МОСКВА RUS", "пятерочка"},
+		{"APTEKA.RU", "apteka"},
+		{"1234 5678", ""},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeDescriptor(tt.in); got != tt.want {
+			t.Errorf("normalizeDescriptor(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestMatchMerchant(t *testing.T) {
+	aliases := []*domain.MerchantAlias{
+		{MerchantID: 1, Pattern: "yandex"},
+		{MerchantID: 2, Pattern: "yandex taxi"},
+		{MerchantID: 3, Pattern: "yandex eda"},
+		{MerchantID: 4, Pattern: "eda"},
+		{MerchantID: 5, Pattern: "bar"},
+	}
+
+	tests := []struct {
+		key  string
+		want int64
+	}{
+		{"yandex taxi", 2},
+		{"yandex market", 1},
+		{"yandex eda", 3},
+		{"bar eda", 4},
+		{"yandexgo", 0},
+		{"uber", 0},
+	}
+
+	for _, tt := range tests {
+		if got := matchMerchant(tt.key, aliases); got != tt.want {
+			t.Errorf("matchMerchant(%q) = %d, want %d", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestMerchantOf(t *testing.T) {
+	aliases := []*domain.MerchantAlias{
+		{MerchantID: 1, Pattern: "yandex"},
+		{MerchantID: 2, Pattern: "yandex taxi"},
+	}
+
+	tests := []struct {
+		description string
+		want        int64
+	}{
+		{"YANDEX*TAXI 4521 MOSCOW", 2},
+		{"Yandex.Plus", 1},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		if got := merchantOf(tt.description, aliases); got != tt.want {
+			t.Errorf("merchantOf(%q) = %d, want %d", tt.description, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	monthLayout = "2006-01"
+	dateLayout  = "2006-01-02"
+)
+
+// occurredAtLayouts are the accepted formats of a client-supplied occurrence
+// time. Layouts without an offset are read in the user's timezone.
+var occurredAtLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	dateLayout,
+	"02.01.2006 15:04",
+	"02.01.2006",
+}
+
+// maxFutureSkew is how far ahead of the server clock an occurrence time may be.
+const maxFutureSkew = 24 * time.Hour
+
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+func dayStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// dateIn moves a date read from a DATE column, which arrives as midnight UTC,
+// to midnight of the same day in loc.
+func dateIn(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// parseMonth parses a "YYYY-MM" month in loc, defaulting to the current one.
+func parseMonth(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return monthStart(time.Now().In(loc)), nil
+	}
+	t, err := time.ParseInLocation(monthLayout, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid month %q, expected YYYY-MM", value)
+	}
+	return t, nil
+}
+
+// parseDateRange parses an inclusive "YYYY-MM-DD" range in loc. An empty from
+// defaults to the start of the current month and an empty to defaults to today.
+func parseDateRange(fromValue, toValue string, loc *time.Location) (time.Time, time.Time, error) {
+	now := time.Now().In(loc)
+	from := monthStart(now)
+	to := dayStart(now)
+
+	var err error
+	if fromValue != "" {
+		if from, err = time.ParseInLocation(dateLayout, fromValue, loc); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", fromValue)
+		}
+	}
+	if toValue != "" {
+		if to, err = time.ParseInLocation(dateLayout, toValue, loc); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", toValue)
+		}
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from %s is after to %s", from.Format(dateLayout), to.Format(dateLayout))
+	}
+	return from, to, nil
+}
+
+// parseOccurredAt parses a client-supplied occurrence time. An empty value
+// means "now".
+func parseOccurredAt(value string, loc *time.Location) (time.Time, error) {
+	if value == "" {
+		return time.Now(), nil
+	}
+	for _, layout := range occurredAtLayouts {
+		t, err := time.ParseInLocation(layout, value, loc)
+		if err != nil {
+			continue
+		}
+		if t.After(time.Now().Add(maxFutureSkew)) {
+			return time.Time{}, fmt.Errorf("occurred_at %q is in the future", value)
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid occurred_at %q, expected RFC 3339 or YYYY-MM-DD", value)
+}
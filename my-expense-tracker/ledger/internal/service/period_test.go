@@ -0,0 +1,111 @@
+package service
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestParseOccurredAt(t *testing.T) {
+	// Clocks in Berlin move from 02:00 to 03:00 on 2026-03-29.
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "Date only is local midnight", value: "2026-03-29", want: time.Date(2026, 3, 28, 23, 0, 0, 0, time.UTC)},
+		{name: "Local time before the DST switch", value: "2026-03-28T12:00", want: time.Date(2026, 3, 28, 11, 0, 0, 0, time.UTC)},
+		{name: "Local time after the DST switch", value: "2026-03-29 12:00", want: time.Date(2026, 3, 29, 10, 0, 0, 0, time.UTC)},
+		{name: "Russian date format", value: "29.03.2026 12:00", want: time.Date(2026, 3, 29, 10, 0, 0, 0, time.UTC)},
+		{name: "Explicit offset wins over the timezone", value: "2026-03-29T12:00:00+03:00", want: time.Date(2026, 3, 29, 9, 0, 0, 0, time.UTC)},
+		{name: "Slightly ahead of the server clock", value: now.Add(time.Hour).Format(time.RFC3339), want: now.Add(time.Hour).Truncate(time.Second)},
+		{name: "Future date rejected", value: now.Add(48 * time.Hour).In(berlin).Format(dateLayout), wantErr: true},
+		{name: "Future time rejected", value: now.Add(25 * time.Hour).Format(time.RFC3339), wantErr: true},
+		{name: "Unknown format", value: "вчера", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseOccurredAt(tt.value, berlin)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOccurredAt(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("parseOccurredAt(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	if got, err := parseOccurredAt("", berlin); err != nil || got.Sub(now) > time.Minute {
+		t.Errorf("parseOccurredAt(\"\") = %v, %v, want now", got, err)
+	}
+}
+
+func TestParseDateRange(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  bool
+	}{
+		{
+			name:     "Range across the DST switch",
+			from:     "2026-03-01",
+			to:       "2026-03-31",
+			wantFrom: time.Date(2026, 3, 1, 0, 0, 0, 0, berlin),
+			wantTo:   time.Date(2026, 3, 31, 0, 0, 0, 0, berlin),
+		},
+		{
+			name:     "Single day",
+			from:     "2026-03-29",
+			to:       "2026-03-29",
+			wantFrom: time.Date(2026, 3, 29, 0, 0, 0, 0, berlin),
+			wantTo:   time.Date(2026, 3, 29, 0, 0, 0, 0, berlin),
+		},
+		{name: "From after to", from: "2026-04-02", to: "2026-04-01", wantErr: true},
+		{name: "Not a date", from: "2026-04", to: "2026-04-30", wantErr: true},
+		{name: "Invalid day", from: "2026-02-01", to: "2026-02-30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := parseDateRange(tt.from, tt.to, berlin)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDateRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !from.Equal(tt.wantFrom) || !to.Equal(tt.wantTo) {
+				t.Errorf("parseDateRange() = %v, %v, want %v, %v", from, to, tt.wantFrom, tt.wantTo)
+			}
+		})
+	}
+
+	// The day of the switch is 23 hours long; the end of the range must
+	// still be the next local midnight.
+	_, to, _ := parseDateRange("2026-03-29", "2026-03-29", berlin)
+	if end := to.AddDate(0, 0, 1); end.Sub(to) != 23*time.Hour {
+		t.Errorf("range end = %v, want 23 hours after %v", end, to)
+	}
+
+	from, to, err := parseDateRange("", "", berlin)
+	now := time.Now().In(berlin)
+	if err != nil || !from.Equal(monthStart(now)) || !to.Equal(dayStart(now)) {
+		t.Errorf("parseDateRange(\"\", \"\") = %v, %v, %v, want the month to date", from, to, err)
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	pivotDefaultMonths = 12
+	pivotMaxMonths     = 120
+)
+
+// GetPivotReport builds a dense category x month table. Rows are sorted by
+// category name and columns run chronologically, so the layout only changes
+// when a new category or month appears.
+func (s *LedgerService) GetPivotReport(ctx context.Context, userID int64, fromMonth, toMonth string) (*domain.PivotReport, error) {
+	loc := s.userLocation(userID)
+	to, err := parseMonth(toMonth, loc)
+	if err != nil {
+		return nil, err
+	}
+	from := to.AddDate(0, -(pivotDefaultMonths - 1), 0)
+	if fromMonth != "" {
+		if from, err = parseMonth(fromMonth, loc); err != nil {
+			return nil, err
+		}
+	}
+	if from.After(to) {
+		return nil, errors.New("from_month must not be after to_month")
+	}
+
+	var periods []time.Time
+	index := make(map[string]int)
+	for m := from; !m.After(to); m = m.AddDate(0, 1, 0) {
+		index[m.Format(monthLayout)] = len(periods)
+		periods = append(periods, m)
+		if len(periods) > pivotMaxMonths {
+			return nil, errors.New("pivot range is too long")
+		}
+	}
+
+	totals, err := s.pg.GetMonthlyTotals(userID, from, to.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return buildPivot(periods, index, totals), nil
+}
+
+// buildPivot lays out the monthly totals in the columns of periods, indexed
+// by month, with every cell rounded to cents.
+func buildPivot(periods []time.Time, index map[string]int, totals []*domain.MonthlyTotal) *domain.PivotReport {
+	report := &domain.PivotReport{Periods: periods, ColumnTotals: make([]float64, len(periods))}
+	byCat := make(map[string]*domain.PivotRow)
+	for _, t := range totals {
+		col, ok := index[t.Month.Format(monthLayout)]
+		if !ok {
+			continue
+		}
+		row, ok := byCat[t.Category]
+		if !ok {
+			row = &domain.PivotRow{Category: t.Category, Values: make([]float64, len(periods))}
+			byCat[t.Category] = row
+			report.Rows = append(report.Rows, row)
+		}
+		row.Values[col] += t.Amount
+		row.Total += t.Amount
+		report.ColumnTotals[col] += t.Amount
+		report.GrandTotal += t.Amount
+	}
+	sort.Slice(report.Rows, func(i, j int) bool { return report.Rows[i].Category < report.Rows[j].Category })
+
+	for _, row := range report.Rows {
+		for i, v := range row.Values {
+			row.Values[i] = round2(v)
+		}
+		row.Total = round2(row.Total)
+	}
+	for i, v := range report.ColumnTotals {
+		report.ColumnTotals[i] = round2(v)
+	}
+	report.GrandTotal = round2(report.GrandTotal)
+	return report
+}
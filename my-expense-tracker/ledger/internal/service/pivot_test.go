@@ -0,0 +1,41 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestBuildPivot(t *testing.T) {
+	jan := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	feb := jan.AddDate(0, 1, 0)
+	periods := []time.Time{jan, feb}
+	index := map[string]int{jan.Format(monthLayout): 0, feb.Format(monthLayout): 1}
+
+	p := buildPivot(periods, index, []*domain.MonthlyTotal{
+		{Month: feb, Category: "Кафе", Amount: 0.1},
+		{Month: feb, Category: "Кафе", Amount: 0.2},
+		{Month: jan, Category: "Еда", Amount: 1000.005},
+		{Month: feb, Category: "Еда", Amount: 99.999},
+		{Month: jan.AddDate(0, -1, 0), Category: "Еда", Amount: 500},
+	})
+
+	if len(p.Rows) != 2 {
+		t.Fatalf("got %d rows, want 2", len(p.Rows))
+	}
+	food, cafe := p.Rows[0], p.Rows[1]
+	if food.Category != "Еда" || cafe.Category != "Кафе" {
+		t.Fatalf("rows = %q, %q, want sorted by category", food.Category, cafe.Category)
+	}
+	if want := []float64{1000.01, 100}; !reflect.DeepEqual(food.Values, want) || food.Total != 1100 {
+		t.Errorf("Еда = %v total %v, want %v total 1100", food.Values, food.Total, want)
+	}
+	if want := []float64{0, 0.3}; !reflect.DeepEqual(cafe.Values, want) || cafe.Total != 0.3 {
+		t.Errorf("Кафе = %v total %v, want %v total 0.3", cafe.Values, cafe.Total, want)
+	}
+	if want := []float64{1000.01, 100.3}; !reflect.DeepEqual(p.ColumnTotals, want) || p.GrandTotal != 1100.3 {
+		t.Errorf("totals = %v grand %v, want %v grand 1100.3", p.ColumnTotals, p.GrandTotal, want)
+	}
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// defaultQuickCategory is used when nothing in the text hints at a category.
+const defaultQuickCategory = "Другое"
+
+var (
+	amountToken = regexp.MustCompile(`^(\d[\d.,]*)(р\.?|руб\.?|₽|rub)?$`)
+	// amountGroup is a thousands group written after a space, as in "1 200,50".
+	amountGroup = regexp.MustCompile(`^(\d{3}(?:[.,]\d{1,2})?)(р\.?|руб\.?|₽|rub)?$`)
+)
+
+var relativeDays = map[string]int{
+	"сегодня":   0,
+	"today":     0,
+	"вчера":     -1,
+	"yesterday": -1,
+	"позавчера": -2,
+}
+
+var (
+	dayWords      = map[string]bool{"день": true, "дня": true, "дней": true, "day": true, "days": true}
+	agoWords      = map[string]bool{"назад": true, "ago": true}
+	currencyWords = map[string]bool{"р": true, "р.": true, "руб": true, "руб.": true, "рублей": true, "рубля": true, "рубль": true, "₽": true, "rub": true}
+)
+
+var quickDateLayouts = []string{dateLayout, "02.01.2006"}
+
+// categoryRules map keyword prefixes to categories for texts that match
+// neither a known category nor the user's history.
+var categoryRules = []struct {
+	category string
+	keywords []string
+}{
+	{"Еда", []string{"кофе", "coffee", "обед", "lunch", "ужин", "dinner", "завтрак", "breakfast", "кафе", "cafe", "ресторан", "restaurant", "пицц", "pizza", "бургер", "burger", "шаурм", "доставк"}},
+	{"Продукты", []string{"продукт", "grocer", "пятерочк", "пятёрочк", "магнит", "перекрест", "ашан", "лента", "вкусвилл", "супермаркет", "supermarket"}},
+	{"Транспорт", []string{"такси", "taxi", "uber", "метро", "metro", "автобус", "электричк", "train", "поезд", "бензин", "fuel", "gas", "парковк", "parking"}},
+	{"Здоровье", []string{"аптек", "pharmacy", "лекарств", "medicine", "врач", "doctor", "стоматолог", "dentist"}},
+	{"Связь", []string{"интернет", "internet", "телефон", "phone", "мобильн", "mobile"}},
+	{"Развлечения", []string{"кино", "cinema", "movie", "театр", "theatre", "theater", "концерт", "concert", "игр", "game"}},
+	{"Одежда", []string{"одежд", "clothes", "обув", "shoes", "куртк", "jacket"}},
+	{"Дом", []string{"аренд", "rent", "квартплат", "коммунал", "utilities", "ремонт"}},
+}
+
+type quickEntry struct {
+	amount     float64
+	occurredAt time.Time
+	words      []string
+}
+
+// parseQuickEntry extracts the amount and date from free text such as
+// "кофе 250", "taxi 480 yesterday" or "1 200,50 продукты пятерочка". The
+// remaining words are returned for categorisation. Relative dates keep the
+// time of day of now; explicit dates start at midnight in now's location.
+func parseQuickEntry(text string, now time.Time) (*quickEntry, error) {
+	tokens := strings.Fields(text)
+	e := &quickEntry{occurredAt: now}
+	hasAmount, hasDate := false, false
+
+	for i := 0; i < len(tokens); i++ {
+		tok := strings.ToLower(tokens[i])
+
+		if !hasDate {
+			if off, ok := relativeDays[tok]; ok {
+				e.occurredAt, hasDate = now.AddDate(0, 0, off), true
+				continue
+			}
+			if n, err := strconv.Atoi(tok); err == nil && i+2 < len(tokens) &&
+				dayWords[strings.ToLower(tokens[i+1])] && agoWords[strings.ToLower(tokens[i+2])] {
+				e.occurredAt, hasDate = now.AddDate(0, 0, -n), true
+				i += 2
+				continue
+			}
+			if d, ok := parseQuickDate(tok, now.Location()); ok {
+				e.occurredAt, hasDate = d, true
+				continue
+			}
+		}
+
+		if !hasAmount {
+			if m := amountToken.FindStringSubmatch(tok); m != nil {
+				number := m[1]
+				grouped := len(number) <= 3 && !strings.ContainsAny(number, ".,")
+				for grouped && m[2] == "" && i+1 < len(tokens) {
+					g := amountGroup.FindStringSubmatch(strings.ToLower(tokens[i+1]))
+					if g == nil {
+						break
+					}
+					number += g[1]
+					grouped = !strings.ContainsAny(g[1], ".,")
+					m = g
+					i++
+				}
+				amount, err := parseAmount(number)
+				if err != nil {
+					return nil, errors.New("invalid amount")
+				}
+				e.amount, hasAmount = amount, true
+				continue
+			}
+		}
+
+		if currencyWords[tok] {
+			continue
+		}
+		e.words = append(e.words, tokens[i])
+	}
+
+	if !hasAmount {
+		return nil, errors.New("no amount found")
+	}
+	if e.amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	if e.occurredAt.After(now.Add(maxFutureSkew)) {
+		return nil, errors.New("date is in the future")
+	}
+	return e, nil
+}
+
+func parseQuickDate(tok string, loc *time.Location) (time.Time, bool) {
+	for _, layout := range quickDateLayouts {
+		if d, err := time.ParseInLocation(layout, tok, loc); err == nil {
+			return d, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// parseAmount reads a number with either a comma or a dot as the decimal
+// separator. When both appear the last one is decimal; a single separator
+// followed by exactly three digits is taken as a thousands separator.
+func parseAmount(s string) (float64, error) {
+	comma, dot := strings.LastIndex(s, ","), strings.LastIndex(s, ".")
+	dec := -1
+	switch {
+	case comma >= 0 && dot >= 0:
+		dec = max(comma, dot)
+	case comma >= 0 || dot >= 0:
+		i := max(comma, dot)
+		if strings.Count(s, s[i:i+1]) == 1 && len(s)-i-1 != 3 {
+			dec = i
+		}
+	}
+
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case i == dec:
+			b.WriteByte('.')
+		}
+	}
+	return strconv.ParseFloat(b.String(), 64)
+}
+
+// ruleCategory returns the category of the first rule with a keyword that
+// starts one of the words, or "" if none match.
+func ruleCategory(words []string) string {
+	for _, rule := range categoryRules {
+		for _, w := range words {
+			w = strings.ToLower(w)
+			for _, k := range rule.keywords {
+				if strings.HasPrefix(w, k) {
+					return rule.category
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// quickCategory picks the category for the words of a quick entry: a word
+// naming one of the user's categories, then the category the user most often
+// gave similar descriptions, then the keyword rules. The category word itself
+// is left out of the returned description words.
+func (s *LedgerService) quickCategory(userID int64, words []string) (string, []string) {
+	categories, err := s.pg.ListCategories(userID)
+	if err != nil {
+		log.Printf("DB error (ListCategories): %v", err)
+	}
+	for i, w := range words {
+		for _, c := range categories {
+			if strings.EqualFold(w, c) {
+				return c, append(words[:i:i], words[i+1:]...)
+			}
+		}
+	}
+
+	var keys []string
+	for _, w := range words {
+		if utf8.RuneCountInString(w) >= 3 {
+			keys = append(keys, strings.ToLower(w))
+		}
+	}
+	if len(keys) > 0 {
+		c, err := s.pg.GuessCategory(userID, keys)
+		if err != nil {
+			log.Printf("DB error (GuessCategory): %v", err)
+		}
+		if c != "" {
+			return c, words
+		}
+	}
+
+	if c := ruleCategory(words); c != "" {
+		return c, words
+	}
+	return defaultQuickCategory, words
+}
+
+// QuickAdd parses free text into a transaction and records it. The parsed
+// entry is returned even when recording fails, so the client can show what
+// was understood.
+func (s *LedgerService) QuickAdd(ctx context.Context, userID int64, text, idempotencyKey string) (*domain.Transaction, *domain.TransactionResult) {
+	e, err := parseQuickEntry(text, time.Now().In(s.userLocation(userID)))
+	if err != nil {
+		return nil, &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+
+	category, words := s.quickCategory(userID, e.words)
+	t := &domain.Transaction{
+		UserID:      userID,
+		Amount:      round2(e.amount),
+		Category:    category,
+		Description: strings.Join(words, " "),
+		OccurredAt:  e.occurredAt,
+	}
+	return t, s.CreateTransaction(ctx, t, idempotencyKey, []byte(text))
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseQuickEntry(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+	now := time.Date(2025, 10, 18, 15, 0, 0, 0, loc)
+
+	tests := []struct {
+		name    string
+		text    string
+		amount  float64
+		at      time.Time
+		words   []string
+		wantErr bool
+	}{
+		{
+			name:   "Russian word and amount",
+			text:   "кофе 250",
+			amount: 250,
+			at:     now,
+			words:  []string{"кофе"},
+		},
+		{
+			name:   "Relative date",
+			text:   "taxi 480 yesterday",
+			amount: 480,
+			at:     now.AddDate(0, 0, -1),
+			words:  []string{"taxi"},
+		},
+		{
+			name:   "Thousands and decimal comma",
+			text:   "1 200,50 продукты пятерочка",
+			amount: 1200.5,
+			at:     now,
+			words:  []string{"продукты", "пятерочка"},
+		},
+		{
+			name:   "Thousands comma",
+			text:   "rent 45,000",
+			amount: 45000,
+			at:     now,
+			words:  []string{"rent"},
+		},
+		{
+			name:   "Both separators",
+			text:   "ноутбук 1.299,99",
+			amount: 1299.99,
+			at:     now,
+			words:  []string{"ноутбук"},
+		},
+		{
+			name:   "Currency suffix and days ago",
+			text:   "аптека 2 дня назад 350р",
+			amount: 350,
+			at:     now.AddDate(0, 0, -2),
+			words:  []string{"аптека"},
+		},
+		{
+			name:   "Explicit date and currency word",
+			text:   "17.10.2025 кино 600 руб",
+			amount: 600,
+			at:     time.Date(2025, 10, 17, 0, 0, 0, 0, loc),
+			words:  []string{"кино"},
+		},
+		{
+			name:    "No amount",
+			text:    "кофе вчера",
+			wantErr: true,
+		},
+		{
+			name:    "Future date",
+			text:    "2025-12-01 отпуск 50000",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := parseQuickEntry(tt.text, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseQuickEntry() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if e.amount != tt.amount || !e.occurredAt.Equal(tt.at) || !reflect.DeepEqual(e.words, tt.words) {
+				t.Errorf("parseQuickEntry() = %v %v %q, want %v %v %q", e.amount, e.occurredAt, e.words, tt.amount, tt.at, tt.words)
+			}
+		})
+	}
+}
+
+func TestRuleCategory(t *testing.T) {
+	tests := []struct {
+		words []string
+		want  string
+	}{
+		{[]string{"Кофе"}, "Еда"},
+		{[]string{"taxi"}, "Транспорт"},
+		{[]string{"продуктов", "в", "ашане"}, "Продукты"},
+		{[]string{"подарок"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := ruleCategory(tt.words); got != tt.want {
+			t.Errorf("ruleCategory(%q) = %q, want %q", tt.words, got, tt.want)
+		}
+	}
+}
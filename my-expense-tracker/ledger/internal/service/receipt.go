@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+	"github.com/yuramishin/expense-tracker/ledger/internal/repository"
+)
+
+// receiptTimeLayouts are the date formats found in the t= field, with and
+// without seconds.
+var receiptTimeLayouts = []string{"20060102T1504", "20060102T150405"}
+
+// receiptPurchase is the n= operation type of an ordinary purchase. Other
+// types (refunds, expenditure receipts) are not expenses.
+const receiptPurchase = 1
+
+// parseReceiptQR parses the query string of a fiscal receipt QR code, e.g.
+// "t=20261018T1230&s=1250.00&fn=7380440700000000&i=12345&fp=1234567890&n=1".
+// The time is the local time of the shop and is read in loc.
+func parseReceiptQR(value string, loc *time.Location) (*domain.Receipt, error) {
+	q, err := url.ParseQuery(strings.TrimSpace(value))
+	if err != nil {
+		return nil, errors.New("invalid receipt QR code")
+	}
+
+	r := &domain.Receipt{FN: q.Get("fn"), FD: q.Get("i"), FP: q.Get("fp"), Operation: receiptPurchase}
+	if r.FN == "" || r.FD == "" || r.FP == "" {
+		return nil, errors.New("receipt QR code has no fiscal identifiers")
+	}
+	for _, v := range []string{r.FN, r.FD, r.FP} {
+		if _, err := strconv.ParseUint(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid fiscal identifier %q", v)
+		}
+	}
+
+	if n := q.Get("n"); n != "" {
+		if r.Operation, err = strconv.Atoi(n); err != nil {
+			return nil, fmt.Errorf("invalid operation type %q", n)
+		}
+	}
+	if r.Operation != receiptPurchase {
+		return nil, errors.New("only purchase receipts can be recorded")
+	}
+
+	if r.Amount, err = strconv.ParseFloat(q.Get("s"), 64); err != nil || r.Amount <= 0 {
+		return nil, fmt.Errorf("invalid receipt sum %q", q.Get("s"))
+	}
+
+	for _, layout := range receiptTimeLayouts {
+		if r.OccurredAt, err = time.ParseInLocation(layout, q.Get("t"), loc); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid receipt time %q", q.Get("t"))
+	}
+	if r.OccurredAt.After(time.Now().Add(maxFutureSkew)) {
+		return nil, fmt.Errorf("receipt time %q is in the future", q.Get("t"))
+	}
+	return r, nil
+}
+
+// CreateFromReceipt records the purchase from a receipt QR code. A receipt
+// that was already recorded is not stored again; the existing transaction is
+// returned instead.
+func (s *LedgerService) CreateFromReceipt(ctx context.Context, userID int64, qr, category, description string) *domain.TransactionResult {
+	r, err := parseReceiptQR(qr, s.userLocation(userID))
+	if err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+
+	if res := s.recordedReceipt(userID, r.FiscalKey()); res != nil {
+		return res
+	}
+
+	t := &domain.Transaction{
+		UserID:      userID,
+		Amount:      r.Amount,
+		Category:    category,
+		Description: description,
+		OccurredAt:  r.OccurredAt,
+		FiscalKey:   r.FiscalKey(),
+	}
+	res, err := s.createTransaction(ctx, t)
+	if errors.Is(err, repository.ErrDuplicateReceipt) {
+		if res := s.recordedReceipt(userID, t.FiscalKey); res != nil {
+			return res
+		}
+	}
+	if err != nil {
+		log.Printf("DB error (CreateFromReceipt): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	return res
+}
+
+// recordedReceipt returns the result for a receipt that is already in the
+// ledger, or nil if it is not.
+func (s *LedgerService) recordedReceipt(userID int64, key string) *domain.TransactionResult {
+	t, err := s.pg.GetTransactionByFiscalKey(userID, key)
+	if err != nil {
+		log.Printf("DB error (GetTransactionByFiscalKey): %v", err)
+		return nil
+	}
+	if t == nil {
+		return nil
+	}
+	return &domain.TransactionResult{Success: true, Message: "Receipt already recorded", TransactionID: t.ID}
+}
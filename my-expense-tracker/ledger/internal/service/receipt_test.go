@@ -0,0 +1,81 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseReceiptQR(t *testing.T) {
+	loc := time.FixedZone("MSK", 3*60*60)
+
+	tests := []struct {
+		name    string
+		qr      string
+		amount  float64
+		at      time.Time
+		key     string
+		wantErr bool
+	}{
+		{
+			name:   "Purchase",
+			qr:     "t=20251018T1230&s=1250.00&fn=7380440700000000&i=12345&fp=1234567890&n=1",
+			amount: 1250,
+			at:     time.Date(2025, 10, 18, 12, 30, 0, 0, loc),
+			key:    "7380440700000000:12345:1234567890",
+		},
+		{
+			name:   "Seconds and no operation type",
+			qr:     "t=20250102T093015&s=99.90&fn=1&i=2&fp=3",
+			amount: 99.9,
+			at:     time.Date(2025, 1, 2, 9, 30, 15, 0, loc),
+			key:    "1:2:3",
+		},
+		{
+			name:   "Surrounding whitespace",
+			qr:     " t=20250102T0930&s=10&fn=1&i=2&fp=3&n=1\n",
+			amount: 10,
+			at:     time.Date(2025, 1, 2, 9, 30, 0, 0, loc),
+			key:    "1:2:3",
+		},
+		{
+			name:    "Refund",
+			qr:      "t=20250102T0930&s=10&fn=1&i=2&fp=3&n=2",
+			wantErr: true,
+		},
+		{
+			name:    "Missing fiscal sign",
+			qr:      "t=20250102T0930&s=10&fn=1&i=2&n=1",
+			wantErr: true,
+		},
+		{
+			name:    "Zero sum",
+			qr:      "t=20250102T0930&s=0.00&fn=1&i=2&fp=3&n=1",
+			wantErr: true,
+		},
+		{
+			name:    "Bad time",
+			qr:      "t=2025-01-02&s=10&fn=1&i=2&fp=3&n=1",
+			wantErr: true,
+		},
+		{
+			name:    "Not a receipt",
+			qr:      "https://example.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseReceiptQR(tt.qr, loc)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseReceiptQR() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if r.Amount != tt.amount || !r.OccurredAt.Equal(tt.at) || r.FiscalKey() != tt.key {
+				t.Errorf("parseReceiptQR() = %v %v %q, want %v %v %q", r.Amount, r.OccurredAt, r.FiscalKey(), tt.amount, tt.at, tt.key)
+			}
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	ledgerDefaultLimit = 100
+	ledgerMaxLimit     = 1000
+)
+
+// refundAttribution returns where the user's refunds reduce spending,
+// falling back to the original purchase when the settings cannot be read.
+func (s *LedgerService) refundAttribution(userID int64) string {
+	attribution, err := s.pg.GetRefundAttribution(userID)
+	if err != nil {
+		log.Printf("DB error (GetRefundAttribution): %v", err)
+		return domain.RefundToPurchase
+	}
+	return attribution
+}
+
+func (s *LedgerService) GetRefundAttribution(ctx context.Context, userID int64) string {
+	return s.refundAttribution(userID)
+}
+
+// SetRefundAttribution chooses whether refunds reduce spending in the month
+// of the purchase or of the refund. It applies to refunds recorded from now
+// on.
+func (s *LedgerService) SetRefundAttribution(ctx context.Context, userID int64, attribution string) error {
+	switch attribution {
+	case domain.RefundToPurchase, domain.RefundToRefund:
+	default:
+		return fmt.Errorf("unknown refund attribution %q", attribution)
+	}
+	return s.pg.SetRefundAttribution(userID, attribution)
+}
+
+// planRefund builds the refund of amount for the expense orig, of which
+// refunded has already been returned. A zero amount refunds the rest.
+func planRefund(orig *domain.Transaction, refunded, amount float64, at time.Time, attribution string) (*domain.Transaction, error) {
+	switch {
+	case orig.Kind != domain.KindExpense:
+		return nil, errors.New("only expenses can be refunded")
+	case orig.ExpenseReportID != 0:
+		return nil, errors.New("expense is claimed in an expense report")
+	case at.Before(orig.OccurredAt):
+		return nil, errors.New("refund cannot precede the purchase")
+	}
+
+	left := round2(orig.Amount - refunded)
+	if left <= 0 {
+		return nil, errors.New("expense is already refunded in full")
+	}
+	if amount == 0 {
+		amount = left
+	}
+	if amount > left {
+		return nil, fmt.Errorf("refund exceeds the %.2f left to refund", left)
+	}
+
+	t := &domain.Transaction{
+		UserID:      orig.UserID,
+		Amount:      amount,
+		Category:    orig.Category,
+		Description: "Возврат: " + orig.Description,
+		OccurredAt:  at,
+		Kind:        domain.KindRefund,
+		MerchantID:  orig.MerchantID,
+		Tags:        orig.Tags,
+		RefundOf:    orig.ID,
+		AppliesAt:   at,
+	}
+	if attribution != domain.RefundToRefund {
+		t.AppliesAt = orig.OccurredAt
+	}
+	return t, nil
+}
+
+// RecordRefund records money returned for an expense, by default all of what
+// is left of it. The refund reduces the expense category's spending, and
+// budgets, in the month set by the user's refund attribution.
+func (s *LedgerService) RecordRefund(ctx context.Context, userID, transactionID int64, amount float64, description string, occurredAt time.Time) *domain.TransactionResult {
+	if amount < 0 {
+		return &domain.TransactionResult{Success: false, Message: "Amount must be positive"}
+	}
+	orig, err := s.pg.GetTransaction(userID, transactionID)
+	if err != nil {
+		log.Printf("DB error (GetTransaction): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if orig == nil {
+		return &domain.TransactionResult{Success: false, Message: "Transaction not found"}
+	}
+	refunded, err := s.pg.GetRefundedAmount(userID, orig.ID)
+	if err != nil {
+		log.Printf("DB error (GetRefundedAmount): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	t, err := planRefund(orig, refunded, amount, occurredAt, s.refundAttribution(userID))
+	if err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+	if description != "" {
+		t.Description = description
+	}
+	if err := validateTransaction(t); err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+
+	reopenFrom := monthStart(t.AppliesAt.In(s.userLocation(userID)))
+	ok, err := s.pg.CreateRefund(t, reopenFrom)
+	if err != nil {
+		log.Printf("DB error (CreateRefund): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if !ok {
+		return &domain.TransactionResult{Success: false, Message: "Refund exceeds the amount left to refund"}
+	}
+
+	s.publishTransaction(t)
+	s.invalidateReport(userID)
+	go func() {
+		if err := s.redis.InvalidateBudgets(context.Background(), userID); err != nil {
+			log.Printf("Redis error (InvalidateBudgets): %v", err)
+		}
+	}()
+	return &domain.TransactionResult{Success: true, Message: "Refunded", TransactionID: t.ID}
+}
+
+// checkRefundedEdit keeps refunds consistent with their expense when an entry
+// is edited: a refund's amount is fixed, and an expense cannot drop below
+// what was refunded of it.
+func (s *LedgerService) checkRefundedEdit(cur *domain.Transaction, amount float64) error {
+	if cur.Kind == domain.KindRefund && amount != cur.Amount {
+		return errors.New("refund amount cannot be changed, delete the refund and record it again")
+	}
+	if cur.Kind != domain.KindExpense || amount >= cur.Amount {
+		return nil
+	}
+	refunded, err := s.pg.GetRefundedAmount(cur.UserID, cur.ID)
+	if err != nil {
+		log.Printf("DB error (GetRefundedAmount): %v", err)
+		return errors.New("DB Error")
+	}
+	if amount < refunded {
+		return fmt.Errorf("amount is below the %.2f already refunded", refunded)
+	}
+	return nil
+}
+
+// ListTransactions returns the ledger entries of every kind between the
+// "YYYY-MM-DD" dates from and to, newest first. Refunds carry the expense
+// they belong to and expenses the amount refunded of them.
+func (s *LedgerService) ListTransactions(ctx context.Context, userID int64, fromValue, toValue string, limit int) ([]*domain.Transaction, error) {
+	from, to, err := parseDateRange(fromValue, toValue, s.userLocation(userID))
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = ledgerDefaultLimit
+	}
+	return s.pg.ListLedger(userID, from, to.AddDate(0, 0, 1), min(limit, ledgerMaxLimit))
+}
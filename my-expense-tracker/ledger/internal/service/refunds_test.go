@@ -0,0 +1,57 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestPlanRefund(t *testing.T) {
+	bought := time.Date(2025, 3, 28, 12, 0, 0, 0, time.UTC)
+	returned := time.Date(2025, 4, 3, 10, 0, 0, 0, time.UTC)
+	expense := domain.Transaction{ID: 7, Amount: 3000, Category: "Одежда", Description: "Куртка", OccurredAt: bought, Kind: domain.KindExpense}
+
+	tests := []struct {
+		name          string
+		orig          domain.Transaction
+		refunded      float64
+		amount        float64
+		at            time.Time
+		attribution   string
+		wantAmount    float64
+		wantAppliesAt time.Time
+		wantErr       bool
+	}{
+		{name: "Full refund by default", orig: expense, at: returned, attribution: domain.RefundToPurchase, wantAmount: 3000, wantAppliesAt: bought},
+		{name: "Rest after partial refund", orig: expense, refunded: 1000, at: returned, attribution: domain.RefundToPurchase, wantAmount: 2000, wantAppliesAt: bought},
+		{name: "Partial refund", orig: expense, amount: 500, at: returned, attribution: domain.RefundToPurchase, wantAmount: 500, wantAppliesAt: bought},
+		{name: "Attributed to refund month", orig: expense, amount: 500, at: returned, attribution: domain.RefundToRefund, wantAmount: 500, wantAppliesAt: returned},
+		{name: "More than left", orig: expense, refunded: 2800, amount: 500, at: returned, wantErr: true},
+		{name: "Already refunded", orig: expense, refunded: 3000, at: returned, wantErr: true},
+		{name: "Before purchase", orig: expense, at: bought.Add(-time.Hour), wantErr: true},
+		{name: "Not an expense", orig: domain.Transaction{ID: 8, Amount: 100, Kind: domain.KindIncome, OccurredAt: bought}, at: returned, wantErr: true},
+		{name: "Claimed in expense report", orig: domain.Transaction{ID: 9, Amount: 100, Kind: domain.KindExpense, OccurredAt: bought, ExpenseReportID: 2}, at: returned, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planRefund(&tt.orig, tt.refunded, tt.amount, tt.at, tt.attribution)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("planRefund() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.Amount != tt.wantAmount {
+				t.Errorf("Amount = %v, want %v", got.Amount, tt.wantAmount)
+			}
+			if !got.AppliesAt.Equal(tt.wantAppliesAt) {
+				t.Errorf("AppliesAt = %v, want %v", got.AppliesAt, tt.wantAppliesAt)
+			}
+			if got.Kind != domain.KindRefund || got.RefundOf != tt.orig.ID || got.Category != tt.orig.Category {
+				t.Errorf("refund = %+v, want a refund of %d in %q", got, tt.orig.ID, tt.orig.Category)
+			}
+		})
+	}
+}
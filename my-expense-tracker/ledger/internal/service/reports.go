@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// reimbursementCategory is the category of recorded reimbursements.
+const reimbursementCategory = "Возмещение"
+
+// reportTransitions maps every status that can be set directly to the status
+// the report must be in. A submitted report can be taken back to a draft;
+// paid is reached by recording the reimbursement.
+var reportTransitions = map[string]string{
+	domain.ReportSubmitted: domain.ReportDraft,
+	domain.ReportApproved:  domain.ReportSubmitted,
+	domain.ReportDraft:     domain.ReportSubmitted,
+}
+
+func checkReportTransition(from, to string) error {
+	want, ok := reportTransitions[to]
+	if !ok {
+		return fmt.Errorf("cannot set report status to %q", to)
+	}
+	if from != want {
+		return fmt.Errorf("report is %s and cannot be marked %s", from, to)
+	}
+	return nil
+}
+
+// CreateExpenseReport starts a draft claim for the expenses with the given
+// ids, or for every reimbursable expense not yet claimed when there are none.
+func (s *LedgerService) CreateExpenseReport(ctx context.Context, userID int64, title string, ids []int64) (*domain.ExpenseReport, error) {
+	title = strings.TrimSpace(title)
+	switch {
+	case title == "":
+		return nil, errors.New("report title cannot be empty")
+	case len(title) > 100:
+		return nil, errors.New("report title too long")
+	}
+
+	seen := make(map[int64]bool)
+	var unique []int64
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	rep := &domain.ExpenseReport{UserID: userID, Title: title}
+	n, err := s.pg.CreateExpenseReport(rep, unique)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errors.New("no reimbursable expenses to claim")
+	}
+	return s.pg.GetExpenseReport(userID, rep.ID)
+}
+
+// SetExpenseReportStatus submits, approves or reopens a report.
+func (s *LedgerService) SetExpenseReportStatus(ctx context.Context, userID, id int64, status string) error {
+	rep, err := s.pg.GetExpenseReport(userID, id)
+	if err != nil {
+		return err
+	}
+	if rep == nil {
+		return errors.New("expense report not found")
+	}
+	if err := checkReportTransition(rep.Status, status); err != nil {
+		return err
+	}
+
+	ok, err := s.pg.SetExpenseReportStatus(userID, id, rep.Status, status)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("report was changed meanwhile, try again")
+	}
+	return nil
+}
+
+// RemoveExpenseReportItem takes an expense off a draft report. It is no
+// longer marked reimbursable, so a report created later without ids does not
+// claim it again.
+func (s *LedgerService) RemoveExpenseReportItem(ctx context.Context, userID, reportID, transactionID int64) (*domain.ExpenseReport, error) {
+	rep, err := s.pg.GetExpenseReport(userID, reportID)
+	if err != nil {
+		return nil, err
+	}
+	if rep == nil {
+		return nil, errors.New("expense report not found")
+	}
+	if rep.Status != domain.ReportDraft {
+		return nil, fmt.Errorf("report is %s, only drafts can be changed", rep.Status)
+	}
+
+	ok, err := s.pg.RemoveExpenseReportItem(userID, reportID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("expense is not on the report or the report was submitted meanwhile")
+	}
+	return s.pg.GetExpenseReport(userID, reportID)
+}
+
+// ReimburseExpenseReport records the reimbursement of an approved report,
+// by default for its full total. Expenses count as personal spending until
+// their report is paid, however far the claim has gone; from then on they
+// no longer do, whatever amount was paid back.
+func (s *LedgerService) ReimburseExpenseReport(ctx context.Context, userID, id int64, amount float64, occurredAt time.Time) *domain.TransactionResult {
+	rep, err := s.pg.GetExpenseReport(userID, id)
+	if err != nil {
+		log.Printf("DB error (GetExpenseReport): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if rep == nil {
+		return &domain.TransactionResult{Success: false, Message: "Expense report not found"}
+	}
+	if rep.Status != domain.ReportApproved {
+		return &domain.TransactionResult{Success: false, Message: fmt.Sprintf("Report is %s, only approved reports can be reimbursed", rep.Status)}
+	}
+
+	if amount == 0 {
+		amount = round2(rep.Total)
+	}
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	t := &domain.Transaction{
+		UserID:      userID,
+		Amount:      amount,
+		Category:    reimbursementCategory,
+		Description: rep.Title,
+		OccurredAt:  occurredAt,
+		Kind:        domain.KindReimbursement,
+	}
+	if err := validateTransaction(t); err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+
+	ok, err := s.pg.ReimburseExpenseReport(rep, t)
+	if err != nil {
+		log.Printf("DB error (ReimburseExpenseReport): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if !ok {
+		return &domain.TransactionResult{Success: false, Message: "Report is no longer approved"}
+	}
+
+	// The expenses on the report no longer count as spending.
+	dates := make([]time.Time, len(rep.Items))
+	for i, item := range rep.Items {
+		dates[i] = item.OccurredAt
+	}
+	s.reopenBudgetPeriods(userID, dates...)
+	go func() {
+		if err := s.redis.InvalidateBudgets(context.Background(), userID); err != nil {
+			log.Printf("Redis error (InvalidateBudgets): %v", err)
+		}
+	}()
+
+	s.publishTransaction(t)
+	s.invalidateReport(userID)
+	return &domain.TransactionResult{Success: true, Message: "Reimbursed", TransactionID: t.ID}
+}
+
+// checkClaimedEdit keeps an expense report consistent with its expenses when
+// one is edited: once the report has left draft, the amount and category of
+// its expenses are fixed, as is the amount of the reimbursement of a paid
+// report.
+func (s *LedgerService) checkClaimedEdit(cur *domain.Transaction, amount float64, category string) error {
+	if cur.Kind == domain.KindReimbursement && amount != cur.Amount {
+		return errors.New("reimbursement amount cannot be changed")
+	}
+	if cur.ExpenseReportID == 0 || (amount == cur.Amount && category == cur.Category) {
+		return nil
+	}
+	rep, err := s.pg.GetExpenseReport(cur.UserID, cur.ExpenseReportID)
+	if err != nil {
+		log.Printf("DB error (GetExpenseReport): %v", err)
+		return errors.New("DB Error")
+	}
+	if rep != nil && rep.Status != domain.ReportDraft {
+		return fmt.Errorf("expense is on a %s expense report, its amount and category cannot be changed", rep.Status)
+	}
+	return nil
+}
+
+// ListExpenseReports returns the user's reports, optionally only those in one
+// status.
+func (s *LedgerService) ListExpenseReports(ctx context.Context, userID int64, status string) ([]*domain.ExpenseReport, error) {
+	switch status {
+	case "", domain.ReportDraft, domain.ReportSubmitted, domain.ReportApproved, domain.ReportPaid:
+	default:
+		return nil, fmt.Errorf("unknown report status %q", status)
+	}
+	return s.pg.ListExpenseReports(userID, status)
+}
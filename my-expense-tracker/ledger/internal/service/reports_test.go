@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestCheckReportTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		wantErr bool
+	}{
+		{name: "Submit draft", from: domain.ReportDraft, to: domain.ReportSubmitted},
+		{name: "Approve submitted", from: domain.ReportSubmitted, to: domain.ReportApproved},
+		{name: "Reopen submitted", from: domain.ReportSubmitted, to: domain.ReportDraft},
+		{name: "Approve draft", from: domain.ReportDraft, to: domain.ReportApproved, wantErr: true},
+		{name: "Reopen approved", from: domain.ReportApproved, to: domain.ReportDraft, wantErr: true},
+		{name: "Paid only by reimbursement", from: domain.ReportApproved, to: domain.ReportPaid, wantErr: true},
+		{name: "Submit paid", from: domain.ReportPaid, to: domain.ReportSubmitted, wantErr: true},
+		{name: "Unknown status", from: domain.ReportDraft, to: "rejected", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkReportTransition(tt.from, tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkReportTransition(%q, %q) error = %v, wantErr %v", tt.from, tt.to, err, tt.wantErr)
+			}
+		})
+	}
+}
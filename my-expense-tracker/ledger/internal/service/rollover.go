@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// applyRollover brings the budget's history up to the current month: every
+// month that has ended since the last closed one is closed with its final
+// spending and the limit that was in force, and its remainder is carried
+// forward under the budget's policy. Months without a limit carry nothing.
+// Months that were reopened because their spending changed are closed again.
+// The current month's limit, carryover and start are set on b; false is
+// returned when the budget's first limit only starts in a later month.
+func (s *LedgerService) applyRollover(userID int64, b *domain.Budget, now time.Time) (bool, error) {
+	current := monthStart(now)
+	periods, err := s.pg.ListBudgetPeriods(userID, b.Category)
+	if err != nil {
+		return false, err
+	}
+	limits, err := s.pg.ListBudgetLimits(userID, b.Category)
+	if err != nil {
+		return false, err
+	}
+
+	from := monthStart(b.CreatedAt.In(now.Location()))
+	var carry float64
+	for _, p := range periods {
+		p.PeriodStart = dateIn(p.PeriodStart, now.Location())
+		if p.Closed && p.PeriodStart.Before(current) {
+			carry = carryOver(p, b.Rollover, b.RolloverCap)
+			from = p.PeriodStart.AddDate(0, 1, 0)
+		}
+	}
+
+	for m := from; m.Before(current); m = m.AddDate(0, 1, 0) {
+		base, ok := limitAt(limits, m)
+		if !ok {
+			carry = 0
+			continue
+		}
+		p := &domain.BudgetPeriod{Category: b.Category, PeriodStart: m, BaseLimit: base, Carryover: carry, Closed: true}
+		if p.Spent, err = s.pg.GetTotalSpent(userID, b.Category, m, m.AddDate(0, 1, 0)); err != nil {
+			return false, err
+		}
+		if err := s.pg.SaveBudgetPeriod(userID, p); err != nil {
+			return false, err
+		}
+		carry = carryOver(p, b.Rollover, b.RolloverCap)
+	}
+
+	// The current month is only stored once it closes.
+	b.PeriodStart = current
+	base, ok := limitAt(limits, current)
+	if !ok {
+		b.Carryover, b.EffectiveLimit = 0, 0
+		return false, nil
+	}
+	b.LimitAmount = base
+	b.Carryover = carry
+	b.EffectiveLimit = round2(base + carry)
+	return true, nil
+}
+
+// carryOver returns what a closed period passes to the next month under the
+// rollover policy, limited to maxCarry in either direction when it is set.
+func carryOver(p *domain.BudgetPeriod, policy string, maxCarry float64) float64 {
+	left := p.BaseLimit + p.Carryover - p.Spent
+	switch policy {
+	case domain.RolloverUnspent:
+		left = max(left, 0)
+	case domain.RolloverOverspent:
+		left = min(left, 0)
+	case domain.RolloverBoth:
+	default:
+		return 0
+	}
+	if maxCarry > 0 {
+		left = max(-maxCarry, min(left, maxCarry))
+	}
+	return round2(left)
+}
+
+// budgetLimit returns the limit in force for the budget's month starting at
+// start: the effective limit for the current month, the closed period's limit
+// for a past month, and otherwise the limit effective at the time. The second
+// result is false when the budget had no limit in that month.
+func (s *LedgerService) budgetLimit(userID int64, b *domain.Budget, start time.Time) (float64, bool) {
+	now := time.Now().In(start.Location())
+	if start.Equal(monthStart(now)) {
+		active, err := s.applyRollover(userID, b, now)
+		if err != nil {
+			log.Printf("DB error (applyRollover): %v", err)
+			return b.LimitAmount, true
+		}
+		return b.EffectiveLimit, active
+	}
+
+	p, err := s.pg.GetBudgetPeriod(userID, b.Category, start)
+	if err != nil {
+		log.Printf("DB error (GetBudgetPeriod): %v", err)
+	}
+	if p != nil && p.Closed {
+		return round2(p.BaseLimit + p.Carryover), true
+	}
+
+	limits, err := s.pg.ListBudgetLimits(userID, b.Category)
+	if err != nil {
+		log.Printf("DB error (ListBudgetLimits): %v", err)
+		return b.LimitAmount, true
+	}
+	return limitAt(limits, start)
+}
+
+// limitAt returns the limit in force for the month starting at start: the
+// latest change effective on or before the month's last day. limits must be
+// ordered as ListBudgetLimits returns them.
+func limitAt(limits []*domain.BudgetLimit, start time.Time) (float64, bool) {
+	end := start.AddDate(0, 1, 0)
+	var limit float64
+	var found bool
+	for _, l := range limits {
+		if !dateIn(l.EffectiveFrom, start.Location()).Before(end) {
+			break
+		}
+		limit, found = l.LimitAmount, true
+	}
+	return limit, found
+}
+
+// ListBudgetLimits returns the limit changes of one budget, or of all budgets
+// when category is empty.
+func (s *LedgerService) ListBudgetLimits(ctx context.Context, userID int64, category string) ([]*domain.BudgetLimit, error) {
+	list, err := s.pg.ListBudgetLimits(userID, category)
+	if err != nil {
+		return nil, err
+	}
+	loc := s.userLocation(userID)
+	for _, l := range list {
+		l.EffectiveFrom = dateIn(l.EffectiveFrom, loc)
+	}
+	return list, nil
+}
+
+// GetBudgetHistory returns the budget's months, oldest first, after bringing
+// them up to date. The current month comes last while a limit is in force.
+func (s *LedgerService) GetBudgetHistory(ctx context.Context, userID int64, category string) ([]*domain.BudgetPeriod, error) {
+	b, err := s.pg.GetBudget(userID, category)
+	if err != nil {
+		return nil, err
+	}
+	loc := s.userLocation(userID)
+	active, err := s.applyRollover(userID, b, time.Now().In(loc))
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.pg.ListBudgetPeriods(userID, category)
+	if err != nil {
+		return nil, err
+	}
+	var list []*domain.BudgetPeriod
+	for _, p := range stored {
+		p.PeriodStart = dateIn(p.PeriodStart, loc)
+		if p.PeriodStart.Before(b.PeriodStart) {
+			list = append(list, p)
+		}
+	}
+	if active {
+		p := &domain.BudgetPeriod{Category: category, PeriodStart: b.PeriodStart, BaseLimit: b.LimitAmount, Carryover: b.Carryover}
+		if p.Spent, err = s.pg.GetTotalSpent(userID, category, b.PeriodStart, b.PeriodStart.AddDate(0, 1, 0)); err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+// reopenBudgetPeriods reopens the closed budget months from the earliest
+// month of times on, after spending in them changed, so that they are closed
+// again with the new totals and carry the right amounts forward. Zero times
+// are ignored.
+func (s *LedgerService) reopenBudgetPeriods(userID int64, times ...time.Time) {
+	loc := s.userLocation(userID)
+	var from time.Time
+	for _, t := range times {
+		if t.IsZero() {
+			continue
+		}
+		if m := monthStart(t.In(loc)); from.IsZero() || m.Before(from) {
+			from = m
+		}
+	}
+	if from.IsZero() || !from.Before(monthStart(time.Now().In(loc))) {
+		return
+	}
+
+	if err := s.pg.ReopenBudgetPeriods(userID, from); err != nil {
+		log.Printf("DB error (ReopenBudgetPeriods): %v", err)
+		return
+	}
+	go func() {
+		if err := s.redis.InvalidateBudgets(context.Background(), userID); err != nil {
+			log.Printf("Redis error (InvalidateBudgets): %v", err)
+		}
+	}()
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestCarryOver(t *testing.T) {
+	tests := []struct {
+		name      string
+		period    domain.BudgetPeriod
+		policy    string
+		maxCarry  float64
+		wantCarry float64
+	}{
+		{name: "Reset", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 3000}, policy: domain.RolloverReset, wantCarry: 0},
+		{name: "Unknown policy resets", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 3000}, policy: "", wantCarry: 0},
+		{name: "Unspent remainder", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 3000}, policy: domain.RolloverUnspent, wantCarry: 2000},
+		{name: "Unspent includes earlier carryover", period: domain.BudgetPeriod{BaseLimit: 5000, Carryover: 1500, Spent: 3000}, policy: domain.RolloverUnspent, wantCarry: 3500},
+		{name: "Unspent ignores overspending", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 6000}, policy: domain.RolloverUnspent, wantCarry: 0},
+		{name: "Overspending deducted", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 6200.5}, policy: domain.RolloverOverspent, wantCarry: -1200.5},
+		{name: "Overspent ignores remainder", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 3000}, policy: domain.RolloverOverspent, wantCarry: 0},
+		{name: "Both carries remainder", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 3000}, policy: domain.RolloverBoth, wantCarry: 2000},
+		{name: "Both carries deduction", period: domain.BudgetPeriod{BaseLimit: 5000, Carryover: -500, Spent: 5000}, policy: domain.RolloverBoth, wantCarry: -500},
+		{name: "Cap on remainder", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 1000}, policy: domain.RolloverUnspent, maxCarry: 1500, wantCarry: 1500},
+		{name: "Cap on deduction", period: domain.BudgetPeriod{BaseLimit: 5000, Spent: 9000}, policy: domain.RolloverBoth, maxCarry: 1500, wantCarry: -1500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := carryOver(&tt.period, tt.policy, tt.maxCarry); got != tt.wantCarry {
+				t.Errorf("carryOver() = %v, want %v", got, tt.wantCarry)
+			}
+		})
+	}
+}
+
+func TestLimitAt(t *testing.T) {
+	limits := []*domain.BudgetLimit{
+		{LimitAmount: 5000, EffectiveFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{LimitAmount: 8000, EffectiveFrom: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{LimitAmount: 6000, EffectiveFrom: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name      string
+		month     time.Time
+		wantLimit float64
+		wantOK    bool
+	}{
+		{name: "Before the first limit", month: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC), wantOK: false},
+		{name: "First limit", month: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), wantLimit: 5000, wantOK: true},
+		{name: "Change during the month applies to it", month: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), wantLimit: 8000, wantOK: true},
+		{name: "Change stays in force", month: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), wantLimit: 8000, wantOK: true},
+		{name: "Latest change", month: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), wantLimit: 6000, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, ok := limitAt(limits, tt.month)
+			if limit != tt.wantLimit || ok != tt.wantOK {
+				t.Errorf("limitAt() = %v, %v, want %v, %v", limit, ok, tt.wantLimit, tt.wantOK)
+			}
+		})
+	}
+}
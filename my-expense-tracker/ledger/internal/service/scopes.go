@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	maxTags      = 10
+	maxTagLength = 30
+)
+
+// normalizeTags lowercases and trims tags, drops a leading "#" and removes
+// empty and repeated tags.
+func normalizeTags(tags []string) []string {
+	var list []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		list = append(list, tag)
+	}
+	return list
+}
+
+func validateTags(tags []string) error {
+	if len(tags) > maxTags {
+		return fmt.Errorf("at most %d tags are allowed", maxTags)
+	}
+	for _, tag := range tags {
+		if len(tag) > maxTagLength {
+			return fmt.Errorf("tag %q too long", tag)
+		}
+	}
+	return nil
+}
+
+// normalizeScope validates a budget scope: a category name, "*" for all
+// spending, "group:<name>" or "tag:<name>".
+func normalizeScope(scope string) (string, error) {
+	scope = strings.TrimSpace(scope)
+	switch {
+	case scope == domain.ScopeAll:
+		return scope, nil
+	case strings.HasPrefix(scope, domain.ScopeTagPrefix):
+		tags := normalizeTags([]string{strings.TrimPrefix(scope, domain.ScopeTagPrefix)})
+		if len(tags) == 0 {
+			return "", errors.New("tag cannot be empty")
+		}
+		if err := validateTags(tags); err != nil {
+			return "", err
+		}
+		return domain.ScopeTagPrefix + tags[0], nil
+	case strings.HasPrefix(scope, domain.ScopeGroupPrefix):
+		name := strings.TrimSpace(strings.TrimPrefix(scope, domain.ScopeGroupPrefix))
+		if name == "" {
+			return "", errors.New("group name cannot be empty")
+		}
+		if len(name) > 50 {
+			return "", errors.New("group name too long")
+		}
+		return domain.ScopeGroupPrefix + name, nil
+	}
+	if err := validateCategory(scope); err != nil {
+		return "", err
+	}
+	return scope, nil
+}
+
+// validateCategory checks a category name. Names that read as a budget scope
+// other than a category are reserved, so a budget scope never means two
+// things.
+func validateCategory(name string) error {
+	switch {
+	case name == "":
+		return errors.New("category cannot be empty")
+	case len(name) > 50:
+		return errors.New("category name too long")
+	case !isCategoryScope(name):
+		return fmt.Errorf("category name %q is reserved for budget scopes", name)
+	}
+	return nil
+}
+
+func isCategoryScope(scope string) bool {
+	return scope != domain.ScopeAll && !strings.HasPrefix(scope, domain.ScopeGroupPrefix) && !strings.HasPrefix(scope, domain.ScopeTagPrefix)
+}
+
+// budgetCovers reports whether t counts towards a budget with the scope.
+// groups maps group names to their categories.
+func budgetCovers(scope string, t *domain.Transaction, groups map[string][]string) bool {
+	switch {
+	case scope == domain.ScopeAll:
+		return true
+	case strings.HasPrefix(scope, domain.ScopeGroupPrefix):
+		for _, cat := range groups[strings.TrimPrefix(scope, domain.ScopeGroupPrefix)] {
+			if cat == t.Category {
+				return true
+			}
+		}
+		return false
+	case strings.HasPrefix(scope, domain.ScopeTagPrefix):
+		for _, tag := range t.Tags {
+			if tag == strings.TrimPrefix(scope, domain.ScopeTagPrefix) {
+				return true
+			}
+		}
+		return false
+	}
+	return scope == t.Category
+}
+
+func budgetExceeded(scope string, limit, spent float64) string {
+	if isCategoryScope(scope) {
+		return fmt.Sprintf("Budget exceeded! Limit: %.0f, Spent: %.0f", limit, spent)
+	}
+	return fmt.Sprintf("Budget %q exceeded! Limit: %.0f, Spent: %.0f", scope, limit, spent)
+}
+
+// budgetCheck is a budget a new or edited transaction was checked against,
+// with its spending before and after the change.
+type budgetCheck struct {
+	scope  string
+	limit  float64
+	before float64
+	after  float64
+}
+
+// coveringBudgets returns a function that lists the budgets a transaction
+// falls under.
+func (s *LedgerService) coveringBudgets(userID int64) (func(t *domain.Transaction) []*domain.Budget, error) {
+	budgets, err := s.pg.GetBudgets(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]string)
+	for _, b := range budgets {
+		if strings.HasPrefix(b.Category, domain.ScopeGroupPrefix) {
+			list, err := s.pg.ListCategoryGroups(userID)
+			if err != nil {
+				return nil, err
+			}
+			for _, g := range list {
+				groups[g.Name] = g.Categories
+			}
+			break
+		}
+	}
+
+	return func(t *domain.Transaction) []*domain.Budget {
+		var list []*domain.Budget
+		for _, b := range budgets {
+			if budgetCovers(b.Category, t, groups) {
+				list = append(list, b)
+			}
+		}
+		return list
+	}, nil
+}
+
+// SetCategoryGroup replaces the categories of a group that budgets can refer
+// to as "group:<name>". An empty list removes the group.
+func (s *LedgerService) SetCategoryGroup(ctx context.Context, userID int64, name string, categories []string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("group name cannot be empty")
+	}
+	if len(name) > 50 {
+		return errors.New("group name too long")
+	}
+
+	g := &domain.CategoryGroup{Name: name}
+	seen := make(map[string]bool)
+	for _, cat := range categories {
+		cat = strings.TrimSpace(cat)
+		if cat == "" || seen[cat] {
+			continue
+		}
+		if err := validateCategory(cat); err != nil {
+			return err
+		}
+		seen[cat] = true
+		g.Categories = append(g.Categories, cat)
+	}
+	if err := s.pg.SetCategoryGroup(userID, g); err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.redis.InvalidateBudgets(context.Background(), userID); err != nil {
+			log.Printf("Redis error (InvalidateBudgets): %v", err)
+		}
+	}()
+	return nil
+}
+
+func (s *LedgerService) ListCategoryGroups(ctx context.Context, userID int64) ([]*domain.CategoryGroup, error) {
+	return s.pg.ListCategoryGroups(userID)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestBudgetCovers(t *testing.T) {
+	groups := map[string][]string{"Дом": {"Аренда", "Коммуналка"}}
+	tx := &domain.Transaction{Category: "Коммуналка", Tags: []string{"vacation", "family"}}
+
+	tests := []struct {
+		name  string
+		scope string
+		want  bool
+	}{
+		{name: "Same category", scope: "Коммуналка", want: true},
+		{name: "Other category", scope: "Еда", want: false},
+		{name: "All spending", scope: domain.ScopeAll, want: true},
+		{name: "Group with category", scope: "group:Дом", want: true},
+		{name: "Unknown group", scope: "group:Отдых", want: false},
+		{name: "Tag present", scope: "tag:vacation", want: true},
+		{name: "Tag missing", scope: "tag:work", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := budgetCovers(tt.scope, tx, groups); got != tt.want {
+				t.Errorf("budgetCovers(%q) = %v, want %v", tt.scope, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeScope(t *testing.T) {
+	tests := []struct {
+		name    string
+		scope   string
+		want    string
+		wantErr bool
+	}{
+		{name: "Category", scope: " Еда ", want: "Еда"},
+		{name: "All spending", scope: "*", want: "*"},
+		{name: "Group", scope: "group: Дом ", want: "group:Дом"},
+		{name: "Tag is normalised", scope: "tag:#Vacation", want: "tag:vacation"},
+		{name: "Empty", scope: " ", wantErr: true},
+		{name: "Empty group", scope: "group:", wantErr: true},
+		{name: "Empty tag", scope: "tag:#", wantErr: true},
+		{name: "Category too long", scope: strings.Repeat("x", 51), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeScope(tt.scope)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeScope(%q) error = %v, wantErr %v", tt.scope, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeScope(%q) = %q, want %q", tt.scope, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCategory(t *testing.T) {
+	tests := []struct {
+		name     string
+		category string
+		wantErr  bool
+	}{
+		{name: "Category", category: "Еда"},
+		{name: "Colon inside", category: "Дом: ремонт"},
+		{name: "Empty", category: "", wantErr: true},
+		{name: "All spending", category: "*", wantErr: true},
+		{name: "Group prefix", category: "group:Дом", wantErr: true},
+		{name: "Tag prefix", category: "tag:vacation", wantErr: true},
+		{name: "Too long", category: strings.Repeat("я", 26), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateCategory(tt.category); (err != nil) != tt.wantErr {
+				t.Errorf("validateCategory(%q) error = %v, wantErr %v", tt.category, err, tt.wantErr)
+			}
+		})
+	}
+}
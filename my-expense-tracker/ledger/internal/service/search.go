@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	searchDefaultLimit = 20
+	searchMaxLimit     = 100
+)
+
+// Search runs a full-text search over the user's transaction descriptions.
+// from and to are optional inclusive "YYYY-MM-DD" dates in the user's timezone.
+func (s *LedgerService) Search(ctx context.Context, userID int64, text, category, from, to string, limit, offset int) ([]*domain.SearchResult, error) {
+	q := &domain.SearchQuery{
+		Text:     strings.TrimSpace(text),
+		Category: strings.TrimSpace(category),
+		Limit:    limit,
+		Offset:   max(offset, 0),
+	}
+	if q.Text == "" {
+		return nil, errors.New("search query is required")
+	}
+	if q.Limit <= 0 {
+		q.Limit = searchDefaultLimit
+	}
+	q.Limit = min(q.Limit, searchMaxLimit)
+
+	loc := s.userLocation(userID)
+	var err error
+	if from != "" {
+		if q.From, err = time.ParseInLocation(dateLayout, from, loc); err != nil {
+			return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", from)
+		}
+	}
+	if to != "" {
+		if q.To, err = time.ParseInLocation(dateLayout, to, loc); err != nil {
+			return nil, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", to)
+		}
+		q.To = q.To.AddDate(0, 0, 1)
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return nil, fmt.Errorf("from %s is after to %s", from, to)
+	}
+
+	return s.pg.Search(userID, q)
+}
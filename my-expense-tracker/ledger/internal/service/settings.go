@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// userLocation returns the user's configured timezone, falling back to the
+// service default when none is set or it cannot be loaded.
+func (s *LedgerService) userLocation(userID int64) *time.Location {
+	tz, err := s.pg.GetTimezone(userID)
+	if err != nil {
+		log.Printf("DB error (GetTimezone): %v", err)
+		return s.defaultZone
+	}
+	if tz == "" {
+		return s.defaultZone
+	}
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		log.Printf("Invalid timezone %q for user %d: %v", tz, userID, err)
+		return s.defaultZone
+	}
+	return loc
+}
+
+func (s *LedgerService) GetTimezone(ctx context.Context, userID int64) string {
+	return s.userLocation(userID).String()
+}
+
+func (s *LedgerService) SetTimezone(ctx context.Context, userID int64, tz string) error {
+	if tz == "" || tz == "Local" {
+		return fmt.Errorf("timezone is required")
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("unknown timezone %q", tz)
+	}
+	if err := s.pg.SetTimezone(userID, tz); err != nil {
+		return err
+	}
+
+	go func() {
+		if err := s.redis.InvalidateReport(context.Background(), userID); err != nil {
+			log.Printf("Redis error (InvalidateReport): %v", err)
+		}
+	}()
+	return nil
+}
+
+func (s *LedgerService) ParseOccurredAt(ctx context.Context, userID int64, value string) (time.Time, error) {
+	return parseOccurredAt(value, s.userLocation(userID))
+}
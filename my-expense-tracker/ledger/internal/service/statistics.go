@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func (s *LedgerService) GetStatistics(ctx context.Context, userID int64, fromValue, toValue string) (*domain.Statistics, error) {
+	from, to, err := parseDateRange(fromValue, toValue, s.userLocation(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.redis.GetStatistics(ctx, userID, from, to)
+	if err != nil {
+		log.Printf("Redis error: %v", err)
+	} else if stats != nil {
+		return stats, nil
+	}
+
+	end := to.AddDate(0, 0, 1)
+	categories, err := s.pg.GetCategoryStats(userID, from, end)
+	if err != nil {
+		return nil, err
+	}
+	weekdays, err := s.pg.GetWeekdayStats(userID, from, end)
+	if err != nil {
+		return nil, err
+	}
+
+	stats = buildStatistics(from, to, categories, weekdays)
+
+	go func() {
+		if err := s.redis.SetStatistics(context.Background(), userID, stats); err != nil {
+			log.Printf("Redis error (SetStatistics): %v", err)
+		}
+	}()
+
+	return stats, nil
+}
+
+// buildStatistics sums up the category and weekday stats of the inclusive
+// range [from, to]. Averages per day and per weekday are spread over every
+// day of the range, not only the days that had spending.
+func buildStatistics(from, to time.Time, categories []*domain.CategoryStats, weekdays []*domain.WeekdayStats) *domain.Statistics {
+	end := to.AddDate(0, 0, 1)
+	stats := &domain.Statistics{From: from, To: to, Categories: categories}
+	for _, c := range categories {
+		stats.Count += c.Count
+		stats.Total += c.Total
+	}
+	days := int(end.Sub(from).Hours()/24 + 0.5)
+	stats.AverageDailySpend = round2(stats.Total / float64(days))
+
+	occurrences := make(map[int]int)
+	for d := from; d.Before(end); d = d.AddDate(0, 0, 1) {
+		occurrences[isoWeekday(d)]++
+	}
+	byDay := make(map[int]*domain.WeekdayStats)
+	for _, w := range weekdays {
+		byDay[w.Weekday] = w
+	}
+	for day := 1; day <= 7; day++ {
+		w, ok := byDay[day]
+		if !ok {
+			w = &domain.WeekdayStats{Weekday: day}
+		}
+		if occurrences[day] > 0 {
+			w.Average = round2(w.Total / float64(occurrences[day]))
+		}
+		stats.Weekdays = append(stats.Weekdays, w)
+	}
+	return stats
+}
+
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestBuildStatistics(t *testing.T) {
+	// 2026-03-02 is a Monday; the range holds two Mondays and one of every
+	// other weekday.
+	from := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)
+
+	// A refund lowers the totals of its category and weekday but is not
+	// counted as a purchase.
+	categories := []*domain.CategoryStats{
+		{Category: "Еда", Count: 3, Total: 2400},
+		{Category: "Электроника", Count: 0, Total: -1000},
+	}
+	weekdays := []*domain.WeekdayStats{
+		{Weekday: 1, Count: 2, Total: 1800},
+		{Weekday: 3, Count: 1, Total: -400},
+	}
+
+	stats := buildStatistics(from, to, categories, weekdays)
+
+	if stats.Count != 3 || stats.Total != 1400 {
+		t.Errorf("Count, Total = %d, %v, want 3, 1400", stats.Count, stats.Total)
+	}
+	if stats.AverageDailySpend != 175 {
+		t.Errorf("AverageDailySpend = %v, want 175", stats.AverageDailySpend)
+	}
+	if len(stats.Weekdays) != 7 {
+		t.Fatalf("got %d weekdays, want 7", len(stats.Weekdays))
+	}
+	want := map[int]float64{1: 900, 2: 0, 3: -400}
+	for day, avg := range want {
+		if got := stats.Weekdays[day-1]; got.Weekday != day || got.Average != avg {
+			t.Errorf("weekday %d: Average = %v, want %v", got.Weekday, got.Average, avg)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+const (
+	// subscriptionHistoryMonths covers three yearly charges.
+	subscriptionHistoryMonths = 25
+	subscriptionMinCharges    = 3
+	// subscriptionPriceRatio bounds the change between consecutive charges;
+	// larger jumps mean the charges are unrelated purchases.
+	subscriptionPriceRatio = 1.5
+	// subscriptionGrace is how many periods a charge may be overdue before the
+	// subscription is considered cancelled.
+	subscriptionGrace = 1.5
+)
+
+var cadences = []struct {
+	name    string
+	days    float64
+	slack   float64
+	months  int
+	perYear float64
+}{
+	{domain.CadenceWeekly, 7, 2, 0, 52},
+	{domain.CadenceMonthly, 30.44, 5, 1, 12},
+	{domain.CadenceQuarterly, 91.31, 12, 3, 4},
+	{domain.CadenceYearly, 365.25, 20, 12, 1},
+}
+
+// detectSubscriptions finds periodic charges in list, which must be ordered by
+// occurrence. Charges are grouped by merchant, or by normalized description
+// when there is none. A group is a subscription when it has at least three
+// charges, every interval matches one cadence, consecutive amounts are close
+// and the last charge is not long overdue.
+func detectSubscriptions(list []*domain.Transaction, merchants map[int64]string, now time.Time) []*domain.Subscription {
+	groups := make(map[string][]*domain.Transaction)
+	var keys []string
+	for _, t := range list {
+		key := ""
+		if t.MerchantID > 0 {
+			key = fmt.Sprintf("merchant:%d", t.MerchantID)
+		} else if d := normalizeDescriptor(t.Description); d != "" {
+			key = "description:" + d
+		}
+		if key == "" {
+			continue
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], t)
+	}
+
+	var result []*domain.Subscription
+	for _, key := range keys {
+		charges := groups[key]
+		if s := detectSubscription(key, charges, now); s != nil {
+			if name := merchants[charges[len(charges)-1].MerchantID]; name != "" {
+				s.Name = name
+			}
+			result = append(result, s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].YearlyCost != result[j].YearlyCost {
+			return result[i].YearlyCost > result[j].YearlyCost
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func detectSubscription(key string, charges []*domain.Transaction, now time.Time) *domain.Subscription {
+	if len(charges) < subscriptionMinCharges {
+		return nil
+	}
+
+	intervals := make([]float64, len(charges)-1)
+	for i := 1; i < len(charges); i++ {
+		intervals[i-1] = charges[i].OccurredAt.Sub(charges[i-1].OccurredAt).Hours() / 24
+		ratio := charges[i].Amount / charges[i-1].Amount
+		if ratio > subscriptionPriceRatio || ratio < 1/subscriptionPriceRatio {
+			return nil
+		}
+	}
+
+	typical := median(intervals)
+	c := -1
+	for i, cadence := range cadences {
+		if math.Abs(typical-cadence.days) <= cadence.slack {
+			c = i
+			break
+		}
+	}
+	if c < 0 {
+		return nil
+	}
+	cadence := cadences[c]
+	for _, d := range intervals {
+		if math.Abs(d-cadence.days) > cadence.slack {
+			return nil
+		}
+	}
+
+	last := charges[len(charges)-1]
+	if now.Sub(last.OccurredAt).Hours()/24 > cadence.days*subscriptionGrace {
+		return nil
+	}
+
+	s := &domain.Subscription{
+		Key:         key,
+		Name:        strings.TrimSpace(last.Description),
+		Category:    last.Category,
+		Cadence:     cadence.name,
+		Amount:      last.Amount,
+		Occurrences: len(charges),
+		LastCharge:  last.OccurredAt,
+		LastID:      last.ID,
+		YearlyCost:  round2(last.Amount * cadence.perYear),
+	}
+	if cadence.months > 0 {
+		s.NextCharge = last.OccurredAt.AddDate(0, cadence.months, 0)
+	} else {
+		s.NextCharge = last.OccurredAt.AddDate(0, 0, int(cadence.days))
+	}
+
+	// The price went up when the charges before the current price run were cheaper.
+	for i := len(charges) - 2; i >= 0; i-- {
+		if math.Abs(charges[i].Amount-last.Amount) <= last.Amount*0.01 {
+			continue
+		}
+		if charges[i].Amount < last.Amount {
+			s.PreviousAmount = charges[i].Amount
+			s.PriceChangedAt = charges[i+1].OccurredAt
+		}
+		break
+	}
+	return s
+}
+
+// RefreshSubscriptions re-runs detection for the user and stores the result.
+// A price increase is recorded and published as an event the first time it
+// is seen.
+func (s *LedgerService) RefreshSubscriptions(ctx context.Context, userID int64) ([]*domain.Subscription, error) {
+	now := time.Now().In(s.userLocation(userID))
+	list, err := s.pg.ListTransactions(userID, now.AddDate(0, -subscriptionHistoryMonths, 0), now.Add(maxFutureSkew))
+	if err != nil {
+		return nil, err
+	}
+	merchants, err := s.pg.ListMerchants(userID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[int64]string, len(merchants))
+	for _, m := range merchants {
+		names[m.ID] = m.Name
+	}
+
+	subs := detectSubscriptions(list, names, now)
+	for _, sub := range subs {
+		sub.UserID = userID
+	}
+	if err := s.pg.SaveSubscriptions(userID, subs); err != nil {
+		return nil, err
+	}
+
+	for _, sub := range subs {
+		if sub.PreviousAmount == 0 || !sub.PriceChangedAt.Equal(sub.LastCharge) {
+			continue
+		}
+		created, err := s.pg.CreatePriceAlert(sub)
+		if err != nil {
+			log.Printf("DB error (CreatePriceAlert): %v", err)
+			continue
+		}
+		if !created {
+			continue
+		}
+		s.publish(userID, &domain.LedgerEvent{
+			Type:           domain.EventSubscriptionPrice,
+			TransactionID:  sub.LastID,
+			Amount:         sub.Amount,
+			PreviousAmount: sub.PreviousAmount,
+			Category:       sub.Category,
+			Description:    sub.Name,
+		})
+	}
+	return subs, nil
+}
+
+func (s *LedgerService) ListSubscriptions(ctx context.Context, userID int64, refresh bool) ([]*domain.Subscription, error) {
+	if refresh {
+		return s.RefreshSubscriptions(ctx, userID)
+	}
+	return s.pg.ListSubscriptions(userID)
+}
+
+// RunSubscriptionScan refreshes the subscriptions of every user with spending
+// in the history window, once at start and then every interval, until ctx is
+// done.
+func (s *LedgerService) RunSubscriptionScan(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		users, err := s.pg.ListActiveUsers(time.Now().AddDate(0, -subscriptionHistoryMonths, 0))
+		if err != nil {
+			log.Printf("DB error (ListActiveUsers): %v", err)
+		}
+		for _, userID := range users {
+			if ctx.Err() != nil {
+				return
+			}
+			if _, err := s.RefreshSubscriptions(ctx, userID); err != nil {
+				log.Printf("Subscription scan error for user %d: %v", userID, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
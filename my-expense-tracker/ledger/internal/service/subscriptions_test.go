@@ -0,0 +1,104 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestDetectSubscription(t *testing.T) {
+	now := time.Date(2026, 6, 20, 12, 0, 0, 0, time.UTC)
+	charge := func(id int64, at time.Time, amount float64) *domain.Transaction {
+		return &domain.Transaction{ID: id, Amount: amount, Category: "Подписки", Description: "Spotify", OccurredAt: at}
+	}
+	monthly := func(amounts ...float64) []*domain.Transaction {
+		var list []*domain.Transaction
+		for i, a := range amounts {
+			list = append(list, charge(int64(i+1), time.Date(2026, time.Month(6-len(amounts)+i+1), 5, 9, 0, 0, 0, time.UTC), a))
+		}
+		return list
+	}
+
+	tests := []struct {
+		name        string
+		charges     []*domain.Transaction
+		want        bool
+		cadence     string
+		yearly      float64
+		next        time.Time
+		previous    float64
+		changedAt   time.Time
+		occurrences int
+	}{
+		{
+			name:        "Monthly",
+			charges:     monthly(299, 299, 299, 299),
+			want:        true,
+			cadence:     domain.CadenceMonthly,
+			yearly:      3588,
+			next:        time.Date(2026, 7, 5, 9, 0, 0, 0, time.UTC),
+			occurrences: 4,
+		},
+		{
+			name: "Weekly",
+			charges: []*domain.Transaction{
+				charge(1, time.Date(2026, 5, 30, 8, 0, 0, 0, time.UTC), 150),
+				charge(2, time.Date(2026, 6, 6, 8, 0, 0, 0, time.UTC), 150),
+				charge(3, time.Date(2026, 6, 13, 9, 30, 0, 0, time.UTC), 150),
+				charge(4, time.Date(2026, 6, 19, 20, 0, 0, 0, time.UTC), 150),
+			},
+			want:        true,
+			cadence:     domain.CadenceWeekly,
+			yearly:      7800,
+			next:        time.Date(2026, 6, 26, 20, 0, 0, 0, time.UTC),
+			occurrences: 4,
+		},
+		{
+			name:        "Price change",
+			charges:     monthly(249, 249, 299),
+			want:        true,
+			cadence:     domain.CadenceMonthly,
+			yearly:      3588,
+			next:        time.Date(2026, 7, 5, 9, 0, 0, 0, time.UTC),
+			previous:    249,
+			changedAt:   time.Date(2026, 6, 5, 9, 0, 0, 0, time.UTC),
+			occurrences: 3,
+		},
+		{
+			name: "Irregular intervals",
+			charges: []*domain.Transaction{
+				charge(1, time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC), 299),
+				charge(2, time.Date(2026, 3, 20, 9, 0, 0, 0, time.UTC), 299),
+				charge(3, time.Date(2026, 5, 2, 9, 0, 0, 0, time.UTC), 299),
+				charge(4, time.Date(2026, 6, 1, 9, 0, 0, 0, time.UTC), 299),
+			},
+		},
+		{name: "Too few charges", charges: monthly(299, 299)},
+		{name: "Unrelated amounts", charges: monthly(299, 1200, 299)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectSubscription("description:spotify", tt.charges, now)
+			if (got != nil) != tt.want {
+				t.Fatalf("detectSubscription() = %+v, want subscription %v", got, tt.want)
+			}
+			if got == nil {
+				return
+			}
+			if got.Cadence != tt.cadence || got.YearlyCost != tt.yearly || !got.NextCharge.Equal(tt.next) || got.Occurrences != tt.occurrences {
+				t.Errorf("detectSubscription() = %s %v next %v x%d, want %s %v next %v x%d",
+					got.Cadence, got.YearlyCost, got.NextCharge, got.Occurrences, tt.cadence, tt.yearly, tt.next, tt.occurrences)
+			}
+			if got.PreviousAmount != tt.previous || !got.PriceChangedAt.Equal(tt.changedAt) {
+				t.Errorf("price change = %v at %v, want %v at %v", got.PreviousAmount, got.PriceChangedAt, tt.previous, tt.changedAt)
+			}
+		})
+	}
+
+	// A monthly charge that stopped coming is no longer a subscription.
+	if got := detectSubscription("description:spotify", monthly(299, 299, 299), now.AddDate(0, 2, 0)); got != nil {
+		t.Errorf("detectSubscription() two months after the last charge = %+v, want nil", got)
+	}
+}
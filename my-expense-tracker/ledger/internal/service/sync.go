@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+	"github.com/yuramishin/expense-tracker/ledger/internal/repository"
+)
+
+const syncChangesLimit = 500
+
+func (s *LedgerService) invalidateReport(userID int64) {
+	go func() {
+		if err := s.redis.InvalidateReport(context.Background(), userID); err != nil {
+			log.Printf("Redis error (InvalidateReport): %v", err)
+		}
+	}()
+}
+
+func (s *LedgerService) UpdateTransaction(ctx context.Context, t *domain.Transaction) *domain.TransactionResult {
+	if err := validateTransaction(t); err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+
+	cur, err := s.pg.GetTransaction(t.UserID, t.ID)
+	if err != nil {
+		log.Printf("DB error (GetTransaction): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if cur == nil {
+		return &domain.TransactionResult{Success: false, Message: "Transaction not found"}
+	}
+	if err := s.checkRefundedEdit(cur, t.Amount); err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+	if err := s.checkClaimedEdit(cur, t.Amount, t.Category); err != nil {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+	if t.OccurredAt.IsZero() {
+		t.OccurredAt = cur.OccurredAt
+	}
+	checks, rejected, err := s.checkEdit(t, cur)
+	if err != nil {
+		log.Printf("DB error (checkEdit): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if rejected != "" {
+		return &domain.TransactionResult{Success: false, Message: rejected}
+	}
+
+	s.resolveMerchants(t.UserID, []*domain.Transaction{t})
+	if _, err := s.pg.UpdateTransaction(t, 0); err != nil {
+		log.Printf("DB error (UpdateTransaction): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	s.reopenBudgetPeriods(t.UserID, cur.OccurredAt, t.OccurredAt)
+	s.afterUpdate(t)
+	s.publishChecks(t.UserID, checks)
+	return &domain.TransactionResult{Success: true, Message: "Updated", TransactionID: t.ID}
+}
+
+func (s *LedgerService) DeleteTransaction(ctx context.Context, userID, id int64) *domain.TransactionResult {
+	cur, err := s.pg.GetTransaction(userID, id)
+	if err != nil {
+		log.Printf("DB error (GetTransaction): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if cur == nil {
+		return &domain.TransactionResult{Success: false, Message: "Transaction not found"}
+	}
+	ok, keys, err := s.pg.DeleteTransaction(userID, id, cur.Version)
+	if deleteRejected(err) {
+		return &domain.TransactionResult{Success: false, Message: err.Error()}
+	}
+	if err != nil {
+		log.Printf("DB error (DeleteTransaction): %v", err)
+		return &domain.TransactionResult{Success: false, Message: "DB Error"}
+	}
+	if !ok {
+		return &domain.TransactionResult{Success: false, Message: "Transaction was changed, try again"}
+	}
+	s.afterDelete(cur, keys)
+	return &domain.TransactionResult{Success: true, Message: "Deleted", TransactionID: id}
+}
+
+// deleteRejected reports whether a transaction could not be deleted because
+// other entries depend on it, which the user is told.
+func deleteRejected(err error) bool {
+	return errors.Is(err, repository.ErrHasRefunds) || errors.Is(err, repository.ErrClaimedExpense) ||
+		errors.Is(err, repository.ErrReimbursementEntry)
+}
+
+// checkEdit runs the budget checks for t, an edit of the entry cur. Only
+// expenses count towards budgets; the fields an edit cannot change are taken
+// from cur.
+func (s *LedgerService) checkEdit(t, cur *domain.Transaction) ([]budgetCheck, string, error) {
+	t.Kind, t.Tags, t.Reimbursed = cur.Kind, cur.Tags, cur.Reimbursed
+	if cur.Kind != domain.KindExpense {
+		return nil, "", nil
+	}
+	return s.checkSpending(t, cur)
+}
+
+func (s *LedgerService) afterUpdate(t *domain.Transaction) {
+	s.invalidateReport(t.UserID)
+	s.publish(t.UserID, &domain.LedgerEvent{
+		Type:          domain.EventTransactionUpdated,
+		TransactionID: t.ID,
+		Amount:        t.Amount,
+		Category:      t.Category,
+		Description:   t.Description,
+	})
+}
+
+func (s *LedgerService) afterDelete(t *domain.Transaction, attachmentKeys []string) {
+	s.deleteBlobs(attachmentKeys)
+	s.reopenBudgetPeriods(t.UserID, t.OccurredAt, t.AppliesAt)
+	s.invalidateReport(t.UserID)
+	s.publish(t.UserID, &domain.LedgerEvent{Type: domain.EventTransactionDeleted, TransactionID: t.ID})
+}
+
+// SyncPush applies rows edited in a sheet. Rows are matched by transaction
+// id, or by the client row UUID for rows the server has not seen yet. When
+// the row's version differs from the server's, both sides changed it and
+// policy decides: the server copy wins by default, client_wins always takes
+// the sheet's copy and latest_wins takes whichever was modified last.
+func (s *LedgerService) SyncPush(ctx context.Context, userID int64, rows []*domain.SyncRow, policy string) ([]*domain.SyncRowResult, error) {
+	switch policy {
+	case "":
+		policy = domain.ConflictServerWins
+	case domain.ConflictServerWins, domain.ConflictClientWins, domain.ConflictLatestWins:
+	default:
+		return nil, fmt.Errorf("unknown conflict policy %q", policy)
+	}
+
+	results := make([]*domain.SyncRowResult, 0, len(rows))
+	for _, r := range rows {
+		r.Transaction.UserID = userID
+		res, err := s.syncRow(ctx, r, policy)
+		if err != nil {
+			log.Printf("DB error (SyncPush): %v", err)
+			res = &domain.SyncRowResult{Status: domain.SyncError, Message: "DB Error"}
+		}
+		res.Row = r.Row
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+func (s *LedgerService) syncRow(ctx context.Context, r *domain.SyncRow, policy string) (*domain.SyncRowResult, error) {
+	if r.Error != "" {
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: r.Error}, nil
+	}
+
+	in := r.Transaction
+	var cur *domain.Transaction
+	var err error
+	if in.ID > 0 {
+		cur, err = s.pg.GetTransaction(in.UserID, in.ID)
+	} else if in.RowUUID != "" {
+		cur, err = s.pg.GetTransactionByRowUUID(in.UserID, in.RowUUID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cur == nil {
+		return s.syncNewRow(ctx, r, policy)
+	}
+	if cur.Kind != domain.KindExpense {
+		// Only expenses are synced with the sheet.
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: "Only expenses can be edited in the sheet"}, nil
+	}
+
+	serverWins := in.Version != cur.Version &&
+		(policy == domain.ConflictServerWins ||
+			(policy == domain.ConflictLatestWins && !r.ModifiedAt.After(cur.UpdatedAt)))
+
+	if r.Deleted {
+		if serverWins {
+			return conflict(cur), nil
+		}
+		ok, keys, err := s.pg.DeleteTransaction(in.UserID, cur.ID, cur.Version)
+		if deleteRejected(err) {
+			return &domain.SyncRowResult{Status: domain.SyncError, Message: err.Error(), Server: &domain.SyncRow{Transaction: cur}}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return s.reloadConflict(in.UserID, cur.ID)
+		}
+		s.afterDelete(cur, keys)
+		return &domain.SyncRowResult{Status: domain.SyncDeleted, Server: &domain.SyncRow{Transaction: cur, Deleted: true}}, nil
+	}
+
+	if in.OccurredAt.IsZero() {
+		in.OccurredAt = cur.OccurredAt
+	}
+	if in.Amount == cur.Amount && in.Category == cur.Category && in.Description == cur.Description && in.OccurredAt.Equal(cur.OccurredAt) {
+		return &domain.SyncRowResult{Status: domain.SyncUnchanged, Server: &domain.SyncRow{Transaction: cur}}, nil
+	}
+	if serverWins {
+		return conflict(cur), nil
+	}
+	if err := validateTransaction(in); err != nil {
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: err.Error(), Server: &domain.SyncRow{Transaction: cur}}, nil
+	}
+	if err := s.checkRefundedEdit(cur, in.Amount); err != nil {
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: err.Error(), Server: &domain.SyncRow{Transaction: cur}}, nil
+	}
+	if err := s.checkClaimedEdit(cur, in.Amount, in.Category); err != nil {
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: err.Error(), Server: &domain.SyncRow{Transaction: cur}}, nil
+	}
+	checks, rejected, err := s.checkEdit(in, cur)
+	if err != nil {
+		return nil, err
+	}
+	if rejected != "" {
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: rejected, Server: &domain.SyncRow{Transaction: cur}}, nil
+	}
+
+	in.ID = cur.ID
+	in.RowUUID = cur.RowUUID
+	s.resolveMerchants(in.UserID, []*domain.Transaction{in})
+	ok, err := s.pg.UpdateTransaction(in, cur.Version)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return s.reloadConflict(in.UserID, cur.ID)
+	}
+	s.reopenBudgetPeriods(in.UserID, cur.OccurredAt, in.OccurredAt)
+	s.afterUpdate(in)
+	s.publishChecks(in.UserID, checks)
+	return &domain.SyncRowResult{Status: domain.SyncUpdated, Server: &domain.SyncRow{Transaction: in}}, nil
+}
+
+// syncNewRow handles a row the server does not have: either it is new in the
+// sheet, or it was deleted on the server while the sheet still had it.
+func (s *LedgerService) syncNewRow(ctx context.Context, r *domain.SyncRow, policy string) (*domain.SyncRowResult, error) {
+	in := r.Transaction
+	deleted, err := s.pg.IsDeleted(in.UserID, in.ID, in.RowUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	gone := &domain.SyncRowResult{Status: domain.SyncDeleted, Server: &domain.SyncRow{Transaction: in, Deleted: true}}
+	if r.Deleted {
+		return gone, nil
+	}
+	if deleted && policy != domain.ConflictClientWins {
+		gone.Message = "Deleted on server"
+		return gone, nil
+	}
+	if in.ID > 0 && !deleted {
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: "Transaction not found"}, nil
+	}
+
+	in.ID = 0
+	res, err := s.createTransaction(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return &domain.SyncRowResult{Status: domain.SyncError, Message: res.Message}, nil
+	}
+	return &domain.SyncRowResult{Status: domain.SyncCreated, Server: &domain.SyncRow{Transaction: in}}, nil
+}
+
+// reloadConflict reports a row that changed between reading and writing it.
+func (s *LedgerService) reloadConflict(userID, id int64) (*domain.SyncRowResult, error) {
+	cur, err := s.pg.GetTransaction(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if cur == nil {
+		return &domain.SyncRowResult{Status: domain.SyncDeleted, Server: &domain.SyncRow{Transaction: &domain.Transaction{ID: id}, Deleted: true}}, nil
+	}
+	return conflict(cur), nil
+}
+
+func conflict(cur *domain.Transaction) *domain.SyncRowResult {
+	return &domain.SyncRowResult{
+		Status:  domain.SyncConflict,
+		Message: "Changed on server",
+		Server:  &domain.SyncRow{Transaction: cur},
+	}
+}
+
+// GetChanges returns expense changes after token and the token to continue
+// from. An empty token returns every expense of the user; so does a token
+// from before changes were ordered by database transaction, as it cannot be
+// mapped onto the new order.
+func (s *LedgerService) GetChanges(ctx context.Context, userID int64, token string) ([]*domain.Change, string, bool, error) {
+	xid, seq, err := parseChangeToken(token)
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	list, horizon, err := s.pg.GetChanges(userID, xid, seq, syncChangesLimit)
+	if err != nil {
+		return nil, "", false, err
+	}
+	more := len(list) == syncChangesLimit
+	if len(list) > 0 {
+		last := list[len(list)-1]
+		xid, seq = last.XID, last.Seq
+	}
+	if !more && horizon > xid {
+		// Everything before the horizon was read; changes made from
+		// now on sort after it.
+		xid, seq = horizon, 0
+	}
+	return list, formatChangeToken(xid, seq), more, nil
+}
+
+// parseChangeToken splits a "<xid>:<seq>" change token.
+func parseChangeToken(token string) (uint64, int64, error) {
+	if token == "" {
+		return 0, 0, nil
+	}
+	xidValue, seqValue, ok := strings.Cut(token, ":")
+	if !ok {
+		return 0, 0, errors.New("invalid change token")
+	}
+	xid, err := strconv.ParseUint(xidValue, 10, 64)
+	if err != nil {
+		return 0, 0, errors.New("invalid change token")
+	}
+	seq, err := strconv.ParseInt(seqValue, 10, 64)
+	if err != nil || seq < 0 {
+		return 0, 0, errors.New("invalid change token")
+	}
+	return xid, seq, nil
+}
+
+func formatChangeToken(xid uint64, seq int64) string {
+	return strconv.FormatUint(xid, 10) + ":" + strconv.FormatInt(seq, 10)
+}
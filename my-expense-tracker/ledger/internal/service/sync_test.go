@@ -0,0 +1,33 @@
+package service
+
+import "testing"
+
+func TestParseChangeToken(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		wantXID uint64
+		wantSeq int64
+		wantErr bool
+	}{
+		{name: "Empty token reads everything"},
+		{name: "Token", token: "7731:42", wantXID: 7731, wantSeq: 42},
+		{name: "Horizon token", token: formatChangeToken(9000, 0), wantXID: 9000},
+		{name: "Missing transaction id", token: "1250", wantErr: true},
+		{name: "Negative sequence", token: "7731:-1", wantErr: true},
+		{name: "Not a token", token: "abc", wantErr: true},
+		{name: "Missing sequence", token: "7731:", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			xid, seq, err := parseChangeToken(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseChangeToken(%q) error = %v, wantErr %v", tt.token, err, tt.wantErr)
+			}
+			if xid != tt.wantXID || seq != tt.wantSeq {
+				t.Errorf("parseChangeToken(%q) = %v, %v, want %v, %v", tt.token, xid, seq, tt.wantXID, tt.wantSeq)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+// SaveBudgetTemplate stores a named set of limits, replacing the template
+// with the same name. With fromCurrent the limits in force this month are
+// saved instead of items.
+func (s *LedgerService) SaveBudgetTemplate(ctx context.Context, userID int64, name string, items []*domain.BudgetTemplateItem, fromCurrent bool) (*domain.BudgetTemplate, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return nil, errors.New("template name cannot be empty")
+	case len(name) > 100:
+		return nil, errors.New("template name too long")
+	case fromCurrent && len(items) > 0:
+		return nil, errors.New("give either budgets or from_current, not both")
+	}
+
+	var err error
+	if fromCurrent {
+		now := time.Now().In(s.userLocation(userID))
+		if items, err = s.limitsInForce(userID, monthStart(now)); err != nil {
+			return nil, err
+		}
+	}
+	if len(items) == 0 {
+		return nil, errors.New("template has no budgets")
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range items {
+		if item.Category, err = normalizeScope(item.Category); err != nil {
+			return nil, err
+		}
+		switch {
+		case item.LimitAmount <= 0:
+			return nil, fmt.Errorf("limit for %q must be positive", item.Category)
+		case seen[item.Category]:
+			return nil, fmt.Errorf("category %q is listed twice", item.Category)
+		}
+		seen[item.Category] = true
+	}
+
+	t := &domain.BudgetTemplate{UserID: userID, Name: name, Items: items}
+	if err := s.pg.SaveBudgetTemplate(t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (s *LedgerService) ListBudgetTemplates(ctx context.Context, userID int64) ([]*domain.BudgetTemplate, error) {
+	return s.pg.ListBudgetTemplates(userID)
+}
+
+// ApplyBudgets sets the limits of a template, or the limits that were in force
+// in the month before, adjusted by adjustPercent, for the month given as
+// "YYYY-MM" (the current one by default). A preview returns the changes
+// without saving them.
+func (s *LedgerService) ApplyBudgets(ctx context.Context, userID int64, template, period string, adjustPercent float64, preview bool) (*domain.BudgetPlan, error) {
+	now := time.Now().In(s.userLocation(userID))
+	start := monthStart(now)
+	if period != "" {
+		var err error
+		if start, err = time.ParseInLocation("2006-01", period, now.Location()); err != nil {
+			return nil, fmt.Errorf("invalid period %q, expected YYYY-MM", period)
+		}
+	}
+	if adjustPercent <= -100 {
+		return nil, errors.New("adjustment must be above -100 percent")
+	}
+
+	plan := &domain.BudgetPlan{PeriodStart: start}
+	var source []*domain.BudgetTemplateItem
+	if template != "" {
+		t, err := s.pg.GetBudgetTemplate(userID, template)
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			return nil, fmt.Errorf("template %q not found", template)
+		}
+		source, plan.Source = t.Items, t.Name
+	} else {
+		var err error
+		if source, err = s.limitsInForce(userID, start.AddDate(0, -1, 0)); err != nil {
+			return nil, err
+		}
+		if len(source) == 0 {
+			return nil, errors.New("no budgets in the previous period")
+		}
+		plan.Source = start.AddDate(0, -1, 0).Format("2006-01")
+	}
+
+	inForce, err := s.limitsInForce(userID, start)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]float64, len(inForce))
+	for _, item := range inForce {
+		current[item.Category] = item.LimitAmount
+	}
+	plan.Changes = planBudgets(source, current, adjustPercent)
+	if preview {
+		return plan, nil
+	}
+
+	limits := make([]*domain.BudgetLimit, len(plan.Changes))
+	for i, c := range plan.Changes {
+		limits[i] = &domain.BudgetLimit{Category: c.Category, LimitAmount: c.NewLimit, EffectiveFrom: start}
+	}
+	if err := s.pg.SetBudgetLimits(userID, limits, dayStart(time.Now().In(s.userLocation(userID)))); err != nil {
+		return nil, err
+	}
+	plan.Applied = true
+
+	for _, c := range plan.Changes {
+		s.publish(userID, &domain.LedgerEvent{Type: domain.EventBudgetChanged, Category: c.Category, LimitAmount: c.NewLimit})
+	}
+	go func() {
+		if err := s.redis.InvalidateBudgets(context.Background(), userID); err != nil {
+			log.Printf("Redis error (InvalidateBudgets): %v", err)
+		}
+	}()
+	return plan, nil
+}
+
+// planBudgets works out the new limit of every source category against the
+// limits currently in force for the target month.
+func planBudgets(source []*domain.BudgetTemplateItem, current map[string]float64, adjustPercent float64) []*domain.BudgetChange {
+	changes := make([]*domain.BudgetChange, 0, len(source))
+	for _, item := range source {
+		previous, had := current[item.Category]
+		changes = append(changes, &domain.BudgetChange{
+			Category:      item.Category,
+			HadLimit:      had,
+			PreviousLimit: previous,
+			NewLimit:      round2(item.LimitAmount * (1 + adjustPercent/100)),
+		})
+	}
+	return changes
+}
+
+// limitsInForce returns the limit of every budget that has one in the month
+// starting at start, ordered by category.
+func (s *LedgerService) limitsInForce(userID int64, start time.Time) ([]*domain.BudgetTemplateItem, error) {
+	limits, err := s.pg.ListBudgetLimits(userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*domain.BudgetTemplateItem
+	for i := 0; i < len(limits); {
+		j := i
+		for j < len(limits) && limits[j].Category == limits[i].Category {
+			j++
+		}
+		if limit, ok := limitAt(limits[i:j], start); ok {
+			items = append(items, &domain.BudgetTemplateItem{Category: limits[i].Category, LimitAmount: limit})
+		}
+		i = j
+	}
+	return items, nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yuramishin/expense-tracker/ledger/internal/domain"
+)
+
+func TestPlanBudgets(t *testing.T) {
+	source := []*domain.BudgetTemplateItem{
+		{Category: "Еда", LimitAmount: 30000},
+		{Category: "Кафе", LimitAmount: 5000},
+	}
+	current := map[string]float64{"Еда": 25000, "Транспорт": 4000}
+
+	tests := []struct {
+		name   string
+		adjust float64
+		want   []*domain.BudgetChange
+	}{
+		{
+			name:   "As is",
+			adjust: 0,
+			want: []*domain.BudgetChange{
+				{Category: "Еда", HadLimit: true, PreviousLimit: 25000, NewLimit: 30000},
+				{Category: "Кафе", NewLimit: 5000},
+			},
+		},
+		{
+			name:   "Raised by ten percent",
+			adjust: 10,
+			want: []*domain.BudgetChange{
+				{Category: "Еда", HadLimit: true, PreviousLimit: 25000, NewLimit: 33000},
+				{Category: "Кафе", NewLimit: 5500},
+			},
+		},
+		{
+			name:   "Cut by a third",
+			adjust: -33.3,
+			want: []*domain.BudgetChange{
+				{Category: "Еда", HadLimit: true, PreviousLimit: 25000, NewLimit: 20010},
+				{Category: "Кафе", NewLimit: 3335},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planBudgets(source, current, tt.adjust)
+			if len(got) != len(tt.want) {
+				t.Fatalf("planBudgets() returned %d changes, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !reflect.DeepEqual(got[i], tt.want[i]) {
+					t.Errorf("change %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -22,13 +22,17 @@ const (
 )
 
 type TransactionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category       string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description    string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	OccurredAt     string                 `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Tags           []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Reimbursable   bool                   `protobuf:"varint,8,opt,name=reimbursable,proto3" json:"reimbursable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TransactionRequest) Reset() {
@@ -89,12 +93,43 @@ func (x *TransactionRequest) GetDescription() string {
 	return ""
 }
 
+func (x *TransactionRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *TransactionRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TransactionRequest) GetReimbursable() bool {
+	if x != nil {
+		return x.Reimbursable
+	}
+	return false
+}
+
 type TransactionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TransactionId  int64                  `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AnomalyScore   float64                `protobuf:"fixed64,4,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
+	AnomalyReasons []string               `protobuf:"bytes,5,rep,name=anomaly_reasons,json=anomalyReasons,proto3" json:"anomaly_reasons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TransactionResponse) Reset() {
@@ -141,6 +176,27 @@ func (x *TransactionResponse) GetMessage() string {
 	return ""
 }
 
+func (x *TransactionResponse) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetAnomalyScore() float64 {
+	if x != nil {
+		return x.AnomalyScore
+	}
+	return 0
+}
+
+func (x *TransactionResponse) GetAnomalyReasons() []string {
+	if x != nil {
+		return x.AnomalyReasons
+	}
+	return nil
+}
+
 type ReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -237,12 +293,14 @@ func (x *ReportResponse) GetByCategory() map[string]float64 {
 	return nil
 }
 
-// === НОВЫЕ СООБЩЕНИЯ ===
 type BudgetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
 	LimitAmount   float64                `protobuf:"fixed64,3,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	Rollover      string                 `protobuf:"bytes,4,opt,name=rollover,proto3" json:"rollover,omitempty"`
+	RolloverCap   float64                `protobuf:"fixed64,5,opt,name=rollover_cap,json=rolloverCap,proto3" json:"rollover_cap,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -298,6 +356,27 @@ func (x *BudgetRequest) GetLimitAmount() float64 {
 	return 0
 }
 
+func (x *BudgetRequest) GetRollover() string {
+	if x != nil {
+		return x.Rollover
+	}
+	return ""
+}
+
+func (x *BudgetRequest) GetRolloverCap() float64 {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return 0
+}
+
+func (x *BudgetRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
 type BudgetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -395,11 +474,16 @@ func (x *GetBudgetsRequest) GetUserId() int64 {
 }
 
 type Budget struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
-	LimitAmount   float64                `protobuf:"fixed64,2,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Category       string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	LimitAmount    float64                `protobuf:"fixed64,2,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	Rollover       string                 `protobuf:"bytes,3,opt,name=rollover,proto3" json:"rollover,omitempty"`
+	RolloverCap    float64                `protobuf:"fixed64,4,opt,name=rollover_cap,json=rolloverCap,proto3" json:"rollover_cap,omitempty"`
+	Carryover      float64                `protobuf:"fixed64,5,opt,name=carryover,proto3" json:"carryover,omitempty"`
+	EffectiveLimit float64                `protobuf:"fixed64,6,opt,name=effective_limit,json=effectiveLimit,proto3" json:"effective_limit,omitempty"`
+	PeriodStart    string                 `protobuf:"bytes,7,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Budget) Reset() {
@@ -446,6 +530,41 @@ func (x *Budget) GetLimitAmount() float64 {
 	return 0
 }
 
+func (x *Budget) GetRollover() string {
+	if x != nil {
+		return x.Rollover
+	}
+	return ""
+}
+
+func (x *Budget) GetRolloverCap() float64 {
+	if x != nil {
+		return x.RolloverCap
+	}
+	return 0
+}
+
+func (x *Budget) GetCarryover() float64 {
+	if x != nil {
+		return x.Carryover
+	}
+	return 0
+}
+
+func (x *Budget) GetEffectiveLimit() float64 {
+	if x != nil {
+		return x.EffectiveLimit
+	}
+	return 0
+}
+
+func (x *Budget) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
 type BudgetList struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Budgets       []*Budget              `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets,omitempty"`
@@ -490,50 +609,8731 @@ func (x *BudgetList) GetBudgets() []*Budget {
 	return nil
 }
 
-var File_proto_ledger_proto protoreflect.FileDescriptor
+type BudgetHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_proto_ledger_proto_rawDesc = "" +
-	"\n" +
-	"\x12proto/ledger.proto\x12\tpb_ledger\"\x83\x01\n" +
-	"\x12TransactionRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
-	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"I\n" +
-	"\x13TransactionResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"(\n" +
-	"\rReportRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xbc\x01\n" +
-	"\x0eReportResponse\x12\x1f\n" +
-	"\vtotal_spend\x18\x01 \x01(\x01R\n" +
-	"totalSpend\x12J\n" +
-	"\vby_category\x18\x02 \x03(\v2).pb_ledger.ReportResponse.ByCategoryEntryR\n" +
-	"byCategory\x1a=\n" +
-	"\x0fByCategoryEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"g\n" +
-	"\rBudgetRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
-	"\bcategory\x18\x02 \x01(\tR\bcategory\x12!\n" +
-	"\flimit_amount\x18\x03 \x01(\x01R\vlimitAmount\"D\n" +
-	"\x0eBudgetResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
-	"\x11GetBudgetsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"G\n" +
-	"\x06Budget\x12\x1a\n" +
-	"\bcategory\x18\x01 \x01(\tR\bcategory\x12!\n" +
-	"\flimit_amount\x18\x02 \x01(\x01R\vlimitAmount\"9\n" +
-	"\n" +
-	"BudgetList\x12+\n" +
-	"\abudgets\x18\x01 \x03(\v2\x11.pb_ledger.BudgetR\abudgets2\xaa\x02\n" +
-	"\rLedgerService\x12R\n" +
-	"\x11CreateTransaction\x12\x1d.pb_ledger.TransactionRequest\x1a\x1e.pb_ledger.TransactionResponse\x12@\n" +
-	"\tGetReport\x12\x18.pb_ledger.ReportRequest\x1a\x19.pb_ledger.ReportResponse\x12@\n" +
-	"\tSetBudget\x12\x18.pb_ledger.BudgetRequest\x1a\x19.pb_ledger.BudgetResponse\x12A\n" +
+func (x *BudgetHistoryRequest) Reset() {
+	*x = BudgetHistoryRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetHistoryRequest) ProtoMessage() {}
+
+func (x *BudgetHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetHistoryRequest.ProtoReflect.Descriptor instead.
+func (*BudgetHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BudgetHistoryRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BudgetHistoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type BudgetPeriod struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart    string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	BaseLimit      float64                `protobuf:"fixed64,2,opt,name=base_limit,json=baseLimit,proto3" json:"base_limit,omitempty"`
+	Carryover      float64                `protobuf:"fixed64,3,opt,name=carryover,proto3" json:"carryover,omitempty"`
+	EffectiveLimit float64                `protobuf:"fixed64,4,opt,name=effective_limit,json=effectiveLimit,proto3" json:"effective_limit,omitempty"`
+	Spent          float64                `protobuf:"fixed64,5,opt,name=spent,proto3" json:"spent,omitempty"`
+	Closed         bool                   `protobuf:"varint,6,opt,name=closed,proto3" json:"closed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BudgetPeriod) Reset() {
+	*x = BudgetPeriod{}
+	mi := &file_proto_ledger_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetPeriod) ProtoMessage() {}
+
+func (x *BudgetPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetPeriod.ProtoReflect.Descriptor instead.
+func (*BudgetPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BudgetPeriod) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *BudgetPeriod) GetBaseLimit() float64 {
+	if x != nil {
+		return x.BaseLimit
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetCarryover() float64 {
+	if x != nil {
+		return x.Carryover
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetEffectiveLimit() float64 {
+	if x != nil {
+		return x.EffectiveLimit
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *BudgetPeriod) GetClosed() bool {
+	if x != nil {
+		return x.Closed
+	}
+	return false
+}
+
+type BudgetHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Periods       []*BudgetPeriod        `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetHistory) Reset() {
+	*x = BudgetHistory{}
+	mi := &file_proto_ledger_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetHistory) ProtoMessage() {}
+
+func (x *BudgetHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetHistory.ProtoReflect.Descriptor instead.
+func (*BudgetHistory) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BudgetHistory) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetHistory) GetPeriods() []*BudgetPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type BudgetLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetLimitsRequest) Reset() {
+	*x = BudgetLimitsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetLimitsRequest) ProtoMessage() {}
+
+func (x *BudgetLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetLimitsRequest.ProtoReflect.Descriptor instead.
+func (*BudgetLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BudgetLimitsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BudgetLimitsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type BudgetLimit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	LimitAmount   float64                `protobuf:"fixed64,2,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetLimit) Reset() {
+	*x = BudgetLimit{}
+	mi := &file_proto_ledger_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetLimit) ProtoMessage() {}
+
+func (x *BudgetLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetLimit.ProtoReflect.Descriptor instead.
+func (*BudgetLimit) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BudgetLimit) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetLimit) GetLimitAmount() float64 {
+	if x != nil {
+		return x.LimitAmount
+	}
+	return 0
+}
+
+func (x *BudgetLimit) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *BudgetLimit) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type BudgetLimitList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        []*BudgetLimit         `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetLimitList) Reset() {
+	*x = BudgetLimitList{}
+	mi := &file_proto_ledger_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetLimitList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetLimitList) ProtoMessage() {}
+
+func (x *BudgetLimitList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetLimitList.ProtoReflect.Descriptor instead.
+func (*BudgetLimitList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BudgetLimitList) GetLimits() []*BudgetLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type BudgetTemplateItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	LimitAmount   float64                `protobuf:"fixed64,2,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetTemplateItem) Reset() {
+	*x = BudgetTemplateItem{}
+	mi := &file_proto_ledger_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetTemplateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetTemplateItem) ProtoMessage() {}
+
+func (x *BudgetTemplateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetTemplateItem.ProtoReflect.Descriptor instead.
+func (*BudgetTemplateItem) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BudgetTemplateItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetTemplateItem) GetLimitAmount() float64 {
+	if x != nil {
+		return x.LimitAmount
+	}
+	return 0
+}
+
+type BudgetTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*BudgetTemplateItem  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	FromCurrent   bool                   `protobuf:"varint,4,opt,name=from_current,json=fromCurrent,proto3" json:"from_current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetTemplateRequest) Reset() {
+	*x = BudgetTemplateRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetTemplateRequest) ProtoMessage() {}
+
+func (x *BudgetTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetTemplateRequest.ProtoReflect.Descriptor instead.
+func (*BudgetTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BudgetTemplateRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BudgetTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetTemplateRequest) GetItems() []*BudgetTemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BudgetTemplateRequest) GetFromCurrent() bool {
+	if x != nil {
+		return x.FromCurrent
+	}
+	return false
+}
+
+type BudgetTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TemplateId    int64                  `protobuf:"varint,3,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetTemplateResponse) Reset() {
+	*x = BudgetTemplateResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetTemplateResponse) ProtoMessage() {}
+
+func (x *BudgetTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetTemplateResponse.ProtoReflect.Descriptor instead.
+func (*BudgetTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BudgetTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BudgetTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BudgetTemplateResponse) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+type ListBudgetTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBudgetTemplatesRequest) Reset() {
+	*x = ListBudgetTemplatesRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBudgetTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBudgetTemplatesRequest) ProtoMessage() {}
+
+func (x *ListBudgetTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBudgetTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListBudgetTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListBudgetTemplatesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type BudgetTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TemplateId    int64                  `protobuf:"varint,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*BudgetTemplateItem  `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetTemplate) Reset() {
+	*x = BudgetTemplate{}
+	mi := &file_proto_ledger_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetTemplate) ProtoMessage() {}
+
+func (x *BudgetTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetTemplate.ProtoReflect.Descriptor instead.
+func (*BudgetTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BudgetTemplate) GetTemplateId() int64 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *BudgetTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BudgetTemplate) GetItems() []*BudgetTemplateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BudgetTemplate) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type BudgetTemplateList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*BudgetTemplate      `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetTemplateList) Reset() {
+	*x = BudgetTemplateList{}
+	mi := &file_proto_ledger_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetTemplateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetTemplateList) ProtoMessage() {}
+
+func (x *BudgetTemplateList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetTemplateList.ProtoReflect.Descriptor instead.
+func (*BudgetTemplateList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BudgetTemplateList) GetTemplates() []*BudgetTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type ApplyBudgetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Template      string                 `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	AdjustPercent float64                `protobuf:"fixed64,4,opt,name=adjust_percent,json=adjustPercent,proto3" json:"adjust_percent,omitempty"`
+	Preview       bool                   `protobuf:"varint,5,opt,name=preview,proto3" json:"preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyBudgetsRequest) Reset() {
+	*x = ApplyBudgetsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyBudgetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBudgetsRequest) ProtoMessage() {}
+
+func (x *ApplyBudgetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBudgetsRequest.ProtoReflect.Descriptor instead.
+func (*ApplyBudgetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ApplyBudgetsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ApplyBudgetsRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *ApplyBudgetsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ApplyBudgetsRequest) GetAdjustPercent() float64 {
+	if x != nil {
+		return x.AdjustPercent
+	}
+	return 0
+}
+
+func (x *ApplyBudgetsRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type BudgetChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	HadLimit      bool                   `protobuf:"varint,2,opt,name=had_limit,json=hadLimit,proto3" json:"had_limit,omitempty"`
+	PreviousLimit float64                `protobuf:"fixed64,3,opt,name=previous_limit,json=previousLimit,proto3" json:"previous_limit,omitempty"`
+	NewLimit      float64                `protobuf:"fixed64,4,opt,name=new_limit,json=newLimit,proto3" json:"new_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BudgetChange) Reset() {
+	*x = BudgetChange{}
+	mi := &file_proto_ledger_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BudgetChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetChange) ProtoMessage() {}
+
+func (x *BudgetChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetChange.ProtoReflect.Descriptor instead.
+func (*BudgetChange) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BudgetChange) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BudgetChange) GetHadLimit() bool {
+	if x != nil {
+		return x.HadLimit
+	}
+	return false
+}
+
+func (x *BudgetChange) GetPreviousLimit() float64 {
+	if x != nil {
+		return x.PreviousLimit
+	}
+	return 0
+}
+
+func (x *BudgetChange) GetNewLimit() float64 {
+	if x != nil {
+		return x.NewLimit
+	}
+	return 0
+}
+
+type ApplyBudgetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Applied       bool                   `protobuf:"varint,5,opt,name=applied,proto3" json:"applied,omitempty"`
+	Changes       []*BudgetChange        `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyBudgetsResponse) Reset() {
+	*x = ApplyBudgetsResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyBudgetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBudgetsResponse) ProtoMessage() {}
+
+func (x *ApplyBudgetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBudgetsResponse.ProtoReflect.Descriptor instead.
+func (*ApplyBudgetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ApplyBudgetsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyBudgetsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApplyBudgetsResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ApplyBudgetsResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ApplyBudgetsResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ApplyBudgetsResponse) GetChanges() []*BudgetChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type CategoryGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Categories    []string               `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryGroupRequest) Reset() {
+	*x = CategoryGroupRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryGroupRequest) ProtoMessage() {}
+
+func (x *CategoryGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryGroupRequest.ProtoReflect.Descriptor instead.
+func (*CategoryGroupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CategoryGroupRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CategoryGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryGroupRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CategoryGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryGroupResponse) Reset() {
+	*x = CategoryGroupResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryGroupResponse) ProtoMessage() {}
+
+func (x *CategoryGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryGroupResponse.ProtoReflect.Descriptor instead.
+func (*CategoryGroupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CategoryGroupResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CategoryGroupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ListCategoryGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryGroupsRequest) Reset() {
+	*x = ListCategoryGroupsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryGroupsRequest) ProtoMessage() {}
+
+func (x *ListCategoryGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListCategoryGroupsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CategoryGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Categories    []string               `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryGroup) Reset() {
+	*x = CategoryGroup{}
+	mi := &file_proto_ledger_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryGroup) ProtoMessage() {}
+
+func (x *CategoryGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryGroup.ProtoReflect.Descriptor instead.
+func (*CategoryGroup) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CategoryGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryGroup) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CategoryGroupList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*CategoryGroup       `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryGroupList) Reset() {
+	*x = CategoryGroupList{}
+	mi := &file_proto_ledger_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryGroupList) ProtoMessage() {}
+
+func (x *CategoryGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryGroupList.ProtoReflect.Descriptor instead.
+func (*CategoryGroupList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CategoryGroupList) GetGroups() []*CategoryGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ForecastRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastRequest) Reset() {
+	*x = ForecastRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastRequest) ProtoMessage() {}
+
+func (x *ForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastRequest.ProtoReflect.Descriptor instead.
+func (*ForecastRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ForecastRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CategoryForecast struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Category         string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Spent            float64                `protobuf:"fixed64,2,opt,name=spent,proto3" json:"spent,omitempty"`
+	Projected        float64                `protobuf:"fixed64,3,opt,name=projected,proto3" json:"projected,omitempty"`
+	RecurringPending float64                `protobuf:"fixed64,4,opt,name=recurring_pending,json=recurringPending,proto3" json:"recurring_pending,omitempty"`
+	LimitAmount      float64                `protobuf:"fixed64,5,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	HasBudget        bool                   `protobuf:"varint,6,opt,name=has_budget,json=hasBudget,proto3" json:"has_budget,omitempty"`
+	LikelyOverspend  bool                   `protobuf:"varint,7,opt,name=likely_overspend,json=likelyOverspend,proto3" json:"likely_overspend,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CategoryForecast) Reset() {
+	*x = CategoryForecast{}
+	mi := &file_proto_ledger_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryForecast) ProtoMessage() {}
+
+func (x *CategoryForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryForecast.ProtoReflect.Descriptor instead.
+func (*CategoryForecast) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CategoryForecast) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryForecast) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetProjected() float64 {
+	if x != nil {
+		return x.Projected
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetRecurringPending() float64 {
+	if x != nil {
+		return x.RecurringPending
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetLimitAmount() float64 {
+	if x != nil {
+		return x.LimitAmount
+	}
+	return 0
+}
+
+func (x *CategoryForecast) GetHasBudget() bool {
+	if x != nil {
+		return x.HasBudget
+	}
+	return false
+}
+
+func (x *CategoryForecast) GetLikelyOverspend() bool {
+	if x != nil {
+		return x.LikelyOverspend
+	}
+	return false
+}
+
+type ForecastResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   string                 `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd     string                 `protobuf:"bytes,2,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	DaysElapsed   int32                  `protobuf:"varint,3,opt,name=days_elapsed,json=daysElapsed,proto3" json:"days_elapsed,omitempty"`
+	DaysTotal     int32                  `protobuf:"varint,4,opt,name=days_total,json=daysTotal,proto3" json:"days_total,omitempty"`
+	Categories    []*CategoryForecast    `protobuf:"bytes,5,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForecastResponse) Reset() {
+	*x = ForecastResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastResponse) ProtoMessage() {}
+
+func (x *ForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForecastResponse.ProtoReflect.Descriptor instead.
+func (*ForecastResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ForecastResponse) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *ForecastResponse) GetPeriodEnd() string {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return ""
+}
+
+func (x *ForecastResponse) GetDaysElapsed() int32 {
+	if x != nil {
+		return x.DaysElapsed
+	}
+	return 0
+}
+
+func (x *ForecastResponse) GetDaysTotal() int32 {
+	if x != nil {
+		return x.DaysTotal
+	}
+	return 0
+}
+
+func (x *ForecastResponse) GetCategories() []*CategoryForecast {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ListAnomaliesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IncludeReviewed bool                   `protobuf:"varint,2,opt,name=include_reviewed,json=includeReviewed,proto3" json:"include_reviewed,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAnomaliesRequest) Reset() {
+	*x = ListAnomaliesRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAnomaliesRequest) ProtoMessage() {}
+
+func (x *ListAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*ListAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListAnomaliesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListAnomaliesRequest) GetIncludeReviewed() bool {
+	if x != nil {
+		return x.IncludeReviewed
+	}
+	return false
+}
+
+type Anomaly struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	Reasons       []string               `protobuf:"bytes,7,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Reviewed      bool                   `protobuf:"varint,8,opt,name=reviewed,proto3" json:"reviewed,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Anomaly) Reset() {
+	*x = Anomaly{}
+	mi := &file_proto_ledger_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Anomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly) ProtoMessage() {}
+
+func (x *Anomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly.ProtoReflect.Descriptor instead.
+func (*Anomaly) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Anomaly) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Anomaly) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Anomaly) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Anomaly) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Anomaly) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Anomaly) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Anomaly) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *Anomaly) GetReviewed() bool {
+	if x != nil {
+		return x.Reviewed
+	}
+	return false
+}
+
+func (x *Anomaly) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AnomalyList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies     []*Anomaly             `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnomalyList) Reset() {
+	*x = AnomalyList{}
+	mi := &file_proto_ledger_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnomalyList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyList) ProtoMessage() {}
+
+func (x *AnomalyList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyList.ProtoReflect.Descriptor instead.
+func (*AnomalyList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AnomalyList) GetAnomalies() []*Anomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+type ReviewAnomalyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAnomalyRequest) Reset() {
+	*x = ReviewAnomalyRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAnomalyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAnomalyRequest) ProtoMessage() {}
+
+func (x *ReviewAnomalyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAnomalyRequest.ProtoReflect.Descriptor instead.
+func (*ReviewAnomalyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReviewAnomalyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReviewAnomalyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReviewAnomalyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAnomalyResponse) Reset() {
+	*x = ReviewAnomalyResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAnomalyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAnomalyResponse) ProtoMessage() {}
+
+func (x *ReviewAnomalyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAnomalyResponse.ProtoReflect.Descriptor instead.
+func (*ReviewAnomalyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReviewAnomalyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReviewAnomalyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type CompareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRequest) ProtoMessage() {}
+
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CompareRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompareRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+type CategoryComparison struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Category          string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Current           float64                `protobuf:"fixed64,2,opt,name=current,proto3" json:"current,omitempty"`
+	Previous          float64                `protobuf:"fixed64,3,opt,name=previous,proto3" json:"previous,omitempty"`
+	YearAgo           float64                `protobuf:"fixed64,4,opt,name=year_ago,json=yearAgo,proto3" json:"year_ago,omitempty"`
+	ChangePrevious    float64                `protobuf:"fixed64,5,opt,name=change_previous,json=changePrevious,proto3" json:"change_previous,omitempty"`
+	ChangePreviousPct float64                `protobuf:"fixed64,6,opt,name=change_previous_pct,json=changePreviousPct,proto3" json:"change_previous_pct,omitempty"`
+	ChangeYearAgo     float64                `protobuf:"fixed64,7,opt,name=change_year_ago,json=changeYearAgo,proto3" json:"change_year_ago,omitempty"`
+	ChangeYearAgoPct  float64                `protobuf:"fixed64,8,opt,name=change_year_ago_pct,json=changeYearAgoPct,proto3" json:"change_year_ago_pct,omitempty"`
+	NewPrevious       bool                   `protobuf:"varint,9,opt,name=new_previous,json=newPrevious,proto3" json:"new_previous,omitempty"`
+	NewYearAgo        bool                   `protobuf:"varint,10,opt,name=new_year_ago,json=newYearAgo,proto3" json:"new_year_ago,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CategoryComparison) Reset() {
+	*x = CategoryComparison{}
+	mi := &file_proto_ledger_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryComparison) ProtoMessage() {}
+
+func (x *CategoryComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryComparison.ProtoReflect.Descriptor instead.
+func (*CategoryComparison) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CategoryComparison) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryComparison) GetCurrent() float64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *CategoryComparison) GetPrevious() float64 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *CategoryComparison) GetYearAgo() float64 {
+	if x != nil {
+		return x.YearAgo
+	}
+	return 0
+}
+
+func (x *CategoryComparison) GetChangePrevious() float64 {
+	if x != nil {
+		return x.ChangePrevious
+	}
+	return 0
+}
+
+func (x *CategoryComparison) GetChangePreviousPct() float64 {
+	if x != nil {
+		return x.ChangePreviousPct
+	}
+	return 0
+}
+
+func (x *CategoryComparison) GetChangeYearAgo() float64 {
+	if x != nil {
+		return x.ChangeYearAgo
+	}
+	return 0
+}
+
+func (x *CategoryComparison) GetChangeYearAgoPct() float64 {
+	if x != nil {
+		return x.ChangeYearAgoPct
+	}
+	return 0
+}
+
+func (x *CategoryComparison) GetNewPrevious() bool {
+	if x != nil {
+		return x.NewPrevious
+	}
+	return false
+}
+
+func (x *CategoryComparison) GetNewYearAgo() bool {
+	if x != nil {
+		return x.NewYearAgo
+	}
+	return false
+}
+
+type CompareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	PreviousMonth string                 `protobuf:"bytes,2,opt,name=previous_month,json=previousMonth,proto3" json:"previous_month,omitempty"`
+	YearAgoMonth  string                 `protobuf:"bytes,3,opt,name=year_ago_month,json=yearAgoMonth,proto3" json:"year_ago_month,omitempty"`
+	Categories    []*CategoryComparison  `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	TopMovers     []*CategoryComparison  `protobuf:"bytes,5,rep,name=top_movers,json=topMovers,proto3" json:"top_movers,omitempty"`
+	Newcomers     []*CategoryComparison  `protobuf:"bytes,6,rep,name=newcomers,proto3" json:"newcomers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareResponse) Reset() {
+	*x = CompareResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareResponse) ProtoMessage() {}
+
+func (x *CompareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareResponse.ProtoReflect.Descriptor instead.
+func (*CompareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CompareResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetPreviousMonth() string {
+	if x != nil {
+		return x.PreviousMonth
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetYearAgoMonth() string {
+	if x != nil {
+		return x.YearAgoMonth
+	}
+	return ""
+}
+
+func (x *CompareResponse) GetCategories() []*CategoryComparison {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetTopMovers() []*CategoryComparison {
+	if x != nil {
+		return x.TopMovers
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetNewcomers() []*CategoryComparison {
+	if x != nil {
+		return x.Newcomers
+	}
+	return nil
+}
+
+type PivotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromMonth     string                 `protobuf:"bytes,2,opt,name=from_month,json=fromMonth,proto3" json:"from_month,omitempty"`
+	ToMonth       string                 `protobuf:"bytes,3,opt,name=to_month,json=toMonth,proto3" json:"to_month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PivotRequest) Reset() {
+	*x = PivotRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PivotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PivotRequest) ProtoMessage() {}
+
+func (x *PivotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PivotRequest.ProtoReflect.Descriptor instead.
+func (*PivotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PivotRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PivotRequest) GetFromMonth() string {
+	if x != nil {
+		return x.FromMonth
+	}
+	return ""
+}
+
+func (x *PivotRequest) GetToMonth() string {
+	if x != nil {
+		return x.ToMonth
+	}
+	return ""
+}
+
+type PivotRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Values        []float64              `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PivotRow) Reset() {
+	*x = PivotRow{}
+	mi := &file_proto_ledger_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PivotRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PivotRow) ProtoMessage() {}
+
+func (x *PivotRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PivotRow.ProtoReflect.Descriptor instead.
+func (*PivotRow) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PivotRow) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PivotRow) GetValues() []float64 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *PivotRow) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PivotReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Periods       []string               `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods,omitempty"`
+	Rows          []*PivotRow            `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	ColumnTotals  []float64              `protobuf:"fixed64,3,rep,packed,name=column_totals,json=columnTotals,proto3" json:"column_totals,omitempty"`
+	GrandTotal    float64                `protobuf:"fixed64,4,opt,name=grand_total,json=grandTotal,proto3" json:"grand_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PivotReport) Reset() {
+	*x = PivotReport{}
+	mi := &file_proto_ledger_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PivotReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PivotReport) ProtoMessage() {}
+
+func (x *PivotReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PivotReport.ProtoReflect.Descriptor instead.
+func (*PivotReport) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PivotReport) GetPeriods() []string {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *PivotReport) GetRows() []*PivotRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *PivotReport) GetColumnTotals() []float64 {
+	if x != nil {
+		return x.ColumnTotals
+	}
+	return nil
+}
+
+func (x *PivotReport) GetGrandTotal() float64 {
+	if x != nil {
+		return x.GrandTotal
+	}
+	return 0
+}
+
+type StatisticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatisticsRequest) Reset() {
+	*x = StatisticsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsRequest) ProtoMessage() {}
+
+func (x *StatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsRequest.ProtoReflect.Descriptor instead.
+func (*StatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StatisticsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StatisticsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StatisticsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type CategoryStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	Mean          float64                `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Median        float64                `protobuf:"fixed64,5,opt,name=median,proto3" json:"median,omitempty"`
+	Min           float64                `protobuf:"fixed64,6,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,7,opt,name=max,proto3" json:"max,omitempty"`
+	P90           float64                `protobuf:"fixed64,8,opt,name=p90,proto3" json:"p90,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryStats) Reset() {
+	*x = CategoryStats{}
+	mi := &file_proto_ledger_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryStats) ProtoMessage() {}
+
+func (x *CategoryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryStats.ProtoReflect.Descriptor instead.
+func (*CategoryStats) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CategoryStats) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CategoryStats) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CategoryStats) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CategoryStats) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *CategoryStats) GetMedian() float64 {
+	if x != nil {
+		return x.Median
+	}
+	return 0
+}
+
+func (x *CategoryStats) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *CategoryStats) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *CategoryStats) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+type WeekdayStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	Average       float64                `protobuf:"fixed64,4,opt,name=average,proto3" json:"average,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeekdayStats) Reset() {
+	*x = WeekdayStats{}
+	mi := &file_proto_ledger_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekdayStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekdayStats) ProtoMessage() {}
+
+func (x *WeekdayStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekdayStats.ProtoReflect.Descriptor instead.
+func (*WeekdayStats) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *WeekdayStats) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *WeekdayStats) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *WeekdayStats) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *WeekdayStats) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+type StatisticsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	From              string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Count             int64                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Total             float64                `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	AverageDailySpend float64                `protobuf:"fixed64,5,opt,name=average_daily_spend,json=averageDailySpend,proto3" json:"average_daily_spend,omitempty"`
+	Categories        []*CategoryStats       `protobuf:"bytes,6,rep,name=categories,proto3" json:"categories,omitempty"`
+	Weekdays          []*WeekdayStats        `protobuf:"bytes,7,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StatisticsResponse) Reset() {
+	*x = StatisticsResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatisticsResponse) ProtoMessage() {}
+
+func (x *StatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatisticsResponse.ProtoReflect.Descriptor instead.
+func (*StatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StatisticsResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *StatisticsResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *StatisticsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatisticsResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *StatisticsResponse) GetAverageDailySpend() float64 {
+	if x != nil {
+		return x.AverageDailySpend
+	}
+	return 0
+}
+
+func (x *StatisticsResponse) GetCategories() []*CategoryStats {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *StatisticsResponse) GetWeekdays() []*WeekdayStats {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+type GetSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSettingsRequest) Reset() {
+	*x = GetSettingsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSettingsRequest) ProtoMessage() {}
+
+func (x *GetSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetSettingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UserSettings struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Timezone          string                 `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	BudgetMode        string                 `protobuf:"bytes,2,opt,name=budget_mode,json=budgetMode,proto3" json:"budget_mode,omitempty"`
+	RefundAttribution string                 `protobuf:"bytes,3,opt,name=refund_attribution,json=refundAttribution,proto3" json:"refund_attribution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_proto_ledger_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UserSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserSettings) GetBudgetMode() string {
+	if x != nil {
+		return x.BudgetMode
+	}
+	return ""
+}
+
+func (x *UserSettings) GetRefundAttribution() string {
+	if x != nil {
+		return x.RefundAttribution
+	}
+	return ""
+}
+
+type SetTimezoneRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTimezoneRequest) Reset() {
+	*x = SetTimezoneRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTimezoneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTimezoneRequest) ProtoMessage() {}
+
+func (x *SetTimezoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTimezoneRequest.ProtoReflect.Descriptor instead.
+func (*SetTimezoneRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetTimezoneRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetTimezoneRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type SetBudgetModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BudgetMode    string                 `protobuf:"bytes,2,opt,name=budget_mode,json=budgetMode,proto3" json:"budget_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetBudgetModeRequest) Reset() {
+	*x = SetBudgetModeRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBudgetModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBudgetModeRequest) ProtoMessage() {}
+
+func (x *SetBudgetModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBudgetModeRequest.ProtoReflect.Descriptor instead.
+func (*SetBudgetModeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SetBudgetModeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetBudgetModeRequest) GetBudgetMode() string {
+	if x != nil {
+		return x.BudgetMode
+	}
+	return ""
+}
+
+type SetRefundAttributionRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RefundAttribution string                 `protobuf:"bytes,2,opt,name=refund_attribution,json=refundAttribution,proto3" json:"refund_attribution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetRefundAttributionRequest) Reset() {
+	*x = SetRefundAttributionRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRefundAttributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRefundAttributionRequest) ProtoMessage() {}
+
+func (x *SetRefundAttributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRefundAttributionRequest.ProtoReflect.Descriptor instead.
+func (*SetRefundAttributionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetRefundAttributionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetRefundAttributionRequest) GetRefundAttribution() string {
+	if x != nil {
+		return x.RefundAttribution
+	}
+	return ""
+}
+
+type SettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettingsResponse) Reset() {
+	*x = SettingsResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsResponse) ProtoMessage() {}
+
+func (x *SettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsResponse.ProtoReflect.Descriptor instead.
+func (*SettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SettingsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SettingsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BatchTransactionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Transaction   *TransactionRequest    `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Atomic        bool                   `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	RowUuid       string                 `protobuf:"bytes,4,opt,name=row_uuid,json=rowUuid,proto3" json:"row_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTransactionItem) Reset() {
+	*x = BatchTransactionItem{}
+	mi := &file_proto_ledger_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransactionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransactionItem) ProtoMessage() {}
+
+func (x *BatchTransactionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransactionItem.ProtoReflect.Descriptor instead.
+func (*BatchTransactionItem) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BatchTransactionItem) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *BatchTransactionItem) GetTransaction() *TransactionRequest {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *BatchTransactionItem) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *BatchTransactionItem) GetRowUuid() string {
+	if x != nil {
+		return x.RowUuid
+	}
+	return ""
+}
+
+type BatchRowResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Row            int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Success        bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	TransactionId  int64                  `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	AnomalyScore   float64                `protobuf:"fixed64,5,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
+	AnomalyReasons []string               `protobuf:"bytes,6,rep,name=anomaly_reasons,json=anomalyReasons,proto3" json:"anomaly_reasons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BatchRowResult) Reset() {
+	*x = BatchRowResult{}
+	mi := &file_proto_ledger_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRowResult) ProtoMessage() {}
+
+func (x *BatchRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRowResult.ProtoReflect.Descriptor instead.
+func (*BatchRowResult) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *BatchRowResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchRowResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchRowResult) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *BatchRowResult) GetAnomalyScore() float64 {
+	if x != nil {
+		return x.AnomalyScore
+	}
+	return 0
+}
+
+func (x *BatchRowResult) GetAnomalyReasons() []string {
+	if x != nil {
+		return x.AnomalyReasons
+	}
+	return nil
+}
+
+type BatchTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Inserted      int32                  `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Failed        int32                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results       []*BatchRowResult      `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchTransactionResponse) Reset() {
+	*x = BatchTransactionResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchTransactionResponse) ProtoMessage() {}
+
+func (x *BatchTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchTransactionResponse.ProtoReflect.Descriptor instead.
+func (*BatchTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchTransactionResponse) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *BatchTransactionResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchTransactionResponse) GetResults() []*BatchRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchTransactionResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type WatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastEventId   string                 `protobuf:"bytes,2,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchRequest) Reset() {
+	*x = WatchRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchRequest) ProtoMessage() {}
+
+func (x *WatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WatchRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WatchRequest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+type LedgerEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TransactionId  int64                  `protobuf:"varint,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category       string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Description    string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	LimitAmount    float64                `protobuf:"fixed64,8,opt,name=limit_amount,json=limitAmount,proto3" json:"limit_amount,omitempty"`
+	Spent          float64                `protobuf:"fixed64,9,opt,name=spent,proto3" json:"spent,omitempty"`
+	Threshold      float64                `protobuf:"fixed64,10,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	PreviousAmount float64                `protobuf:"fixed64,11,opt,name=previous_amount,json=previousAmount,proto3" json:"previous_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LedgerEvent) Reset() {
+	*x = LedgerEvent{}
+	mi := &file_proto_ledger_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEvent) ProtoMessage() {}
+
+func (x *LedgerEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEvent.ProtoReflect.Descriptor instead.
+func (*LedgerEvent) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LedgerEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LedgerEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LedgerEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *LedgerEvent) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *LedgerEvent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerEvent) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *LedgerEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerEvent) GetLimitAmount() float64 {
+	if x != nil {
+		return x.LimitAmount
+	}
+	return 0
+}
+
+func (x *LedgerEvent) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *LedgerEvent) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *LedgerEvent) GetPreviousAmount() float64 {
+	if x != nil {
+		return x.PreviousAmount
+	}
+	return 0
+}
+
+type UpdateTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransactionRequest) Reset() {
+	*x = UpdateTransactionRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransactionRequest) ProtoMessage() {}
+
+func (x *UpdateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateTransactionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateTransactionRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTransactionRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type DeleteTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTransactionRequest) Reset() {
+	*x = DeleteTransactionRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTransactionRequest) ProtoMessage() {}
+
+func (x *DeleteTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteTransactionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteTransactionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SyncRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	RowUuid       string                 `protobuf:"bytes,3,opt,name=row_uuid,json=rowUuid,proto3" json:"row_uuid,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Deleted       bool                   `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Version       int32                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRow) Reset() {
+	*x = SyncRow{}
+	mi := &file_proto_ledger_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRow) ProtoMessage() {}
+
+func (x *SyncRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRow.ProtoReflect.Descriptor instead.
+func (*SyncRow) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SyncRow) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SyncRow) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SyncRow) GetRowUuid() string {
+	if x != nil {
+		return x.RowUuid
+	}
+	return ""
+}
+
+func (x *SyncRow) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SyncRow) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SyncRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SyncRow) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *SyncRow) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *SyncRow) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SyncRow) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type SyncPushRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rows           []*SyncRow             `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	ConflictPolicy string                 `protobuf:"bytes,3,opt,name=conflict_policy,json=conflictPolicy,proto3" json:"conflict_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SyncPushRequest) Reset() {
+	*x = SyncPushRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPushRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPushRequest) ProtoMessage() {}
+
+func (x *SyncPushRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPushRequest.ProtoReflect.Descriptor instead.
+func (*SyncPushRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SyncPushRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SyncPushRequest) GetRows() []*SyncRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *SyncPushRequest) GetConflictPolicy() string {
+	if x != nil {
+		return x.ConflictPolicy
+	}
+	return ""
+}
+
+type SyncRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Server        *SyncRow               `protobuf:"bytes,4,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRowResult) Reset() {
+	*x = SyncRowResult{}
+	mi := &file_proto_ledger_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRowResult) ProtoMessage() {}
+
+func (x *SyncRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRowResult.ProtoReflect.Descriptor instead.
+func (*SyncRowResult) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SyncRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *SyncRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SyncRowResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SyncRowResult) GetServer() *SyncRow {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type SyncPushResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SyncRowResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncPushResponse) Reset() {
+	*x = SyncPushResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncPushResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncPushResponse) ProtoMessage() {}
+
+func (x *SyncPushResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncPushResponse.ProtoReflect.Descriptor instead.
+func (*SyncPushResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SyncPushResponse) GetResults() []*SyncRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SinceToken    string                 `protobuf:"bytes,2,opt,name=since_token,json=sinceToken,proto3" json:"since_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangesRequest) Reset() {
+	*x = ChangesRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesRequest) ProtoMessage() {}
+
+func (x *ChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesRequest.ProtoReflect.Descriptor instead.
+func (*ChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ChangesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangesRequest) GetSinceToken() string {
+	if x != nil {
+		return x.SinceToken
+	}
+	return ""
+}
+
+type ChangesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*SyncRow             `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	ChangeToken   string                 `protobuf:"bytes,2,opt,name=change_token,json=changeToken,proto3" json:"change_token,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangesResponse) Reset() {
+	*x = ChangesResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesResponse) ProtoMessage() {}
+
+func (x *ChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesResponse.ProtoReflect.Descriptor instead.
+func (*ChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ChangesResponse) GetChanges() []*SyncRow {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ChangesResponse) GetChangeToken() string {
+	if x != nil {
+		return x.ChangeToken
+	}
+	return ""
+}
+
+func (x *ChangesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type CreateGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount  float64                `protobuf:"fixed64,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	Deadline      string                 `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGoalRequest) Reset() {
+	*x = CreateGoalRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGoalRequest) ProtoMessage() {}
+
+func (x *CreateGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateGoalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateGoalRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGoalRequest) GetTargetAmount() float64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *CreateGoalRequest) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+type GoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	GoalId        int64                  `protobuf:"varint,3,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoalResponse) Reset() {
+	*x = GoalResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalResponse) ProtoMessage() {}
+
+func (x *GoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalResponse.ProtoReflect.Descriptor instead.
+func (*GoalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GoalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GoalResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GoalResponse) GetGoalId() int64 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+type ContributionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GoalId        int64                  `protobuf:"varint,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContributionRequest) Reset() {
+	*x = ContributionRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContributionRequest) ProtoMessage() {}
+
+func (x *ContributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContributionRequest.ProtoReflect.Descriptor instead.
+func (*ContributionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ContributionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ContributionRequest) GetGoalId() int64 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+func (x *ContributionRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ContributionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ContributionRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type GetGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGoalsRequest) Reset() {
+	*x = GetGoalsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGoalsRequest) ProtoMessage() {}
+
+func (x *GetGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGoalsRequest.ProtoReflect.Descriptor instead.
+func (*GetGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetGoalsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GoalProgress struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	GoalId              int64                  `protobuf:"varint,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	TargetAmount        float64                `protobuf:"fixed64,3,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	Deadline            string                 `protobuf:"bytes,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Saved               float64                `protobuf:"fixed64,5,opt,name=saved,proto3" json:"saved,omitempty"`
+	Remaining           float64                `protobuf:"fixed64,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Percent             float64                `protobuf:"fixed64,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	MonthsLeft          int32                  `protobuf:"varint,8,opt,name=months_left,json=monthsLeft,proto3" json:"months_left,omitempty"`
+	RequiredMonthly     float64                `protobuf:"fixed64,9,opt,name=required_monthly,json=requiredMonthly,proto3" json:"required_monthly,omitempty"`
+	AverageMonthly      float64                `protobuf:"fixed64,10,opt,name=average_monthly,json=averageMonthly,proto3" json:"average_monthly,omitempty"`
+	ProjectedCompletion string                 `protobuf:"bytes,11,opt,name=projected_completion,json=projectedCompletion,proto3" json:"projected_completion,omitempty"`
+	OnTrack             bool                   `protobuf:"varint,12,opt,name=on_track,json=onTrack,proto3" json:"on_track,omitempty"`
+	Completed           bool                   `protobuf:"varint,13,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GoalProgress) Reset() {
+	*x = GoalProgress{}
+	mi := &file_proto_ledger_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalProgress) ProtoMessage() {}
+
+func (x *GoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalProgress.ProtoReflect.Descriptor instead.
+func (*GoalProgress) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GoalProgress) GetGoalId() int64 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GoalProgress) GetTargetAmount() float64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *GoalProgress) GetSaved() float64 {
+	if x != nil {
+		return x.Saved
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetMonthsLeft() int32 {
+	if x != nil {
+		return x.MonthsLeft
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetRequiredMonthly() float64 {
+	if x != nil {
+		return x.RequiredMonthly
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetAverageMonthly() float64 {
+	if x != nil {
+		return x.AverageMonthly
+	}
+	return 0
+}
+
+func (x *GoalProgress) GetProjectedCompletion() string {
+	if x != nil {
+		return x.ProjectedCompletion
+	}
+	return ""
+}
+
+func (x *GoalProgress) GetOnTrack() bool {
+	if x != nil {
+		return x.OnTrack
+	}
+	return false
+}
+
+func (x *GoalProgress) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+type GoalList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*GoalProgress        `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GoalList) Reset() {
+	*x = GoalList{}
+	mi := &file_proto_ledger_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GoalList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GoalList) ProtoMessage() {}
+
+func (x *GoalList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GoalList.ProtoReflect.Descriptor instead.
+func (*GoalList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GoalList) GetGoals() []*GoalProgress {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type Attachment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_proto_ledger_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Attachment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attachment) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *Attachment) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type UploadAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UploadAttachmentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UploadAttachmentRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *UploadAttachmentRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadAttachmentRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Attachment    *Attachment            `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentResponse) Reset() {
+	*x = AttachmentResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentResponse) ProtoMessage() {}
+
+func (x *AttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentResponse.ProtoReflect.Descriptor instead.
+func (*AttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AttachmentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AttachmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+type GetAttachmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttachmentRequest) Reset() {
+	*x = GetAttachmentRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttachmentRequest) ProtoMessage() {}
+
+func (x *GetAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAttachmentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAttachmentRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AttachmentData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Attachment    *Attachment            `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentData) Reset() {
+	*x = AttachmentData{}
+	mi := &file_proto_ledger_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentData) ProtoMessage() {}
+
+func (x *AttachmentData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentData.ProtoReflect.Descriptor instead.
+func (*AttachmentData) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AttachmentData) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AttachmentData) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AttachmentData) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AttachmentData) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListAttachmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttachmentsRequest) Reset() {
+	*x = ListAttachmentsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttachmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttachmentsRequest) ProtoMessage() {}
+
+func (x *ListAttachmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttachmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttachmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListAttachmentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListAttachmentsRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type AttachmentList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attachments   []*Attachment          `protobuf:"bytes,1,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentList) Reset() {
+	*x = AttachmentList{}
+	mi := &file_proto_ledger_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentList) ProtoMessage() {}
+
+func (x *AttachmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentList.ProtoReflect.Descriptor instead.
+func (*AttachmentList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AttachmentList) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type ReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Qr            string                 `protobuf:"bytes,2,opt,name=qr,proto3" json:"qr,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptRequest) Reset() {
+	*x = ReceiptRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptRequest) ProtoMessage() {}
+
+func (x *ReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptRequest.ProtoReflect.Descriptor instead.
+func (*ReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ReceiptRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReceiptRequest) GetQr() string {
+	if x != nil {
+		return x.Qr
+	}
+	return ""
+}
+
+func (x *ReceiptRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ReceiptRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type QuickAddRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text           string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuickAddRequest) Reset() {
+	*x = QuickAddRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddRequest) ProtoMessage() {}
+
+func (x *QuickAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddRequest.ProtoReflect.Descriptor instead.
+func (*QuickAddRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *QuickAddRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *QuickAddRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *QuickAddRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type QuickAddResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	TransactionId  int64                  `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category       string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Description    string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt     string                 `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	AnomalyScore   float64                `protobuf:"fixed64,8,opt,name=anomaly_score,json=anomalyScore,proto3" json:"anomaly_score,omitempty"`
+	AnomalyReasons []string               `protobuf:"bytes,9,rep,name=anomaly_reasons,json=anomalyReasons,proto3" json:"anomaly_reasons,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *QuickAddResponse) Reset() {
+	*x = QuickAddResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuickAddResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuickAddResponse) ProtoMessage() {}
+
+func (x *QuickAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuickAddResponse.ProtoReflect.Descriptor instead.
+func (*QuickAddResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *QuickAddResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QuickAddResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *QuickAddResponse) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *QuickAddResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *QuickAddResponse) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *QuickAddResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *QuickAddResponse) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *QuickAddResponse) GetAnomalyScore() float64 {
+	if x != nil {
+		return x.AnomalyScore
+	}
+	return 0
+}
+
+func (x *QuickAddResponse) GetAnomalyReasons() []string {
+	if x != nil {
+		return x.AnomalyReasons
+	}
+	return nil
+}
+
+type SearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	From          string                 `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,7,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRequest) Reset() {
+	*x = SearchRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRequest) ProtoMessage() {}
+
+func (x *SearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
+func (*SearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SearchRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type SearchHit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Kind          string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Rank          float64                `protobuf:"fixed64,7,opt,name=rank,proto3" json:"rank,omitempty"`
+	Highlight     string                 `protobuf:"bytes,8,opt,name=highlight,proto3" json:"highlight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	mi := &file_proto_ledger_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SearchHit) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *SearchHit) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SearchHit) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *SearchHit) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SearchHit) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *SearchHit) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SearchHit) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchHit) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+type SearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hits          []*SearchHit           `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResponse) Reset() {
+	*x = SearchResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResponse) ProtoMessage() {}
+
+func (x *SearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
+func (*SearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SearchResponse) GetHits() []*SearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+type Merchant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Merchant) Reset() {
+	*x = Merchant{}
+	mi := &file_proto_ledger_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Merchant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Merchant) ProtoMessage() {}
+
+func (x *Merchant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Merchant.ProtoReflect.Descriptor instead.
+func (*Merchant) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Merchant) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Merchant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Merchant) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type CreateMerchantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,3,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMerchantRequest) Reset() {
+	*x = CreateMerchantRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMerchantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMerchantRequest) ProtoMessage() {}
+
+func (x *CreateMerchantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMerchantRequest.ProtoReflect.Descriptor instead.
+func (*CreateMerchantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateMerchantRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateMerchantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateMerchantRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type MerchantAliasRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Pattern       string                 `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantAliasRequest) Reset() {
+	*x = MerchantAliasRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantAliasRequest) ProtoMessage() {}
+
+func (x *MerchantAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantAliasRequest.ProtoReflect.Descriptor instead.
+func (*MerchantAliasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MerchantAliasRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MerchantAliasRequest) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MerchantAliasRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type MerchantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MerchantId    int64                  `protobuf:"varint,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantResponse) Reset() {
+	*x = MerchantResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantResponse) ProtoMessage() {}
+
+func (x *MerchantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantResponse.ProtoReflect.Descriptor instead.
+func (*MerchantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *MerchantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *MerchantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MerchantResponse) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+type ListMerchantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMerchantsRequest) Reset() {
+	*x = ListMerchantsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMerchantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMerchantsRequest) ProtoMessage() {}
+
+func (x *ListMerchantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMerchantsRequest.ProtoReflect.Descriptor instead.
+func (*ListMerchantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListMerchantsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type MerchantList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Merchants     []*Merchant            `protobuf:"bytes,1,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantList) Reset() {
+	*x = MerchantList{}
+	mi := &file_proto_ledger_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantList) ProtoMessage() {}
+
+func (x *MerchantList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantList.ProtoReflect.Descriptor instead.
+func (*MerchantList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *MerchantList) GetMerchants() []*Merchant {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+type TopMerchantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopMerchantsRequest) Reset() {
+	*x = TopMerchantsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopMerchantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopMerchantsRequest) ProtoMessage() {}
+
+func (x *TopMerchantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopMerchantsRequest.ProtoReflect.Descriptor instead.
+func (*TopMerchantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *TopMerchantsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TopMerchantsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TopMerchantsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TopMerchantsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type MerchantStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MerchantId    int64                  `protobuf:"varint,1,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Total         float64                `protobuf:"fixed64,3,opt,name=total,proto3" json:"total,omitempty"`
+	Visits        int32                  `protobuf:"varint,4,opt,name=visits,proto3" json:"visits,omitempty"`
+	Average       float64                `protobuf:"fixed64,5,opt,name=average,proto3" json:"average,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MerchantStats) Reset() {
+	*x = MerchantStats{}
+	mi := &file_proto_ledger_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MerchantStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MerchantStats) ProtoMessage() {}
+
+func (x *MerchantStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MerchantStats.ProtoReflect.Descriptor instead.
+func (*MerchantStats) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MerchantStats) GetMerchantId() int64 {
+	if x != nil {
+		return x.MerchantId
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MerchantStats) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetVisits() int32 {
+	if x != nil {
+		return x.Visits
+	}
+	return 0
+}
+
+func (x *MerchantStats) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+type TopMerchantsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Merchants     []*MerchantStats       `protobuf:"bytes,3,rep,name=merchants,proto3" json:"merchants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopMerchantsResponse) Reset() {
+	*x = TopMerchantsResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopMerchantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopMerchantsResponse) ProtoMessage() {}
+
+func (x *TopMerchantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopMerchantsResponse.ProtoReflect.Descriptor instead.
+func (*TopMerchantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *TopMerchantsResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TopMerchantsResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TopMerchantsResponse) GetMerchants() []*MerchantStats {
+	if x != nil {
+		return x.Merchants
+	}
+	return nil
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Refresh       bool                   `protobuf:"varint,2,opt,name=refresh,proto3" json:"refresh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListSubscriptionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSubscriptionsRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type Subscription struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Category       string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Cadence        string                 `protobuf:"bytes,3,opt,name=cadence,proto3" json:"cadence,omitempty"`
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Occurrences    int32                  `protobuf:"varint,5,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	LastCharge     string                 `protobuf:"bytes,6,opt,name=last_charge,json=lastCharge,proto3" json:"last_charge,omitempty"`
+	NextCharge     string                 `protobuf:"bytes,7,opt,name=next_charge,json=nextCharge,proto3" json:"next_charge,omitempty"`
+	YearlyCost     float64                `protobuf:"fixed64,8,opt,name=yearly_cost,json=yearlyCost,proto3" json:"yearly_cost,omitempty"`
+	PreviousAmount float64                `protobuf:"fixed64,9,opt,name=previous_amount,json=previousAmount,proto3" json:"previous_amount,omitempty"`
+	PriceChangedAt string                 `protobuf:"bytes,10,opt,name=price_changed_at,json=priceChangedAt,proto3" json:"price_changed_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_proto_ledger_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *Subscription) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Subscription) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Subscription) GetCadence() string {
+	if x != nil {
+		return x.Cadence
+	}
+	return ""
+}
+
+func (x *Subscription) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Subscription) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *Subscription) GetLastCharge() string {
+	if x != nil {
+		return x.LastCharge
+	}
+	return ""
+}
+
+func (x *Subscription) GetNextCharge() string {
+	if x != nil {
+		return x.NextCharge
+	}
+	return ""
+}
+
+func (x *Subscription) GetYearlyCost() float64 {
+	if x != nil {
+		return x.YearlyCost
+	}
+	return 0
+}
+
+func (x *Subscription) GetPreviousAmount() float64 {
+	if x != nil {
+		return x.PreviousAmount
+	}
+	return 0
+}
+
+func (x *Subscription) GetPriceChangedAt() string {
+	if x != nil {
+		return x.PriceChangedAt
+	}
+	return ""
+}
+
+type SubscriptionList struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions   []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	TotalYearlyCost float64                `protobuf:"fixed64,2,opt,name=total_yearly_cost,json=totalYearlyCost,proto3" json:"total_yearly_cost,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubscriptionList) Reset() {
+	*x = SubscriptionList{}
+	mi := &file_proto_ledger_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionList) ProtoMessage() {}
+
+func (x *SubscriptionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionList.ProtoReflect.Descriptor instead.
+func (*SubscriptionList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SubscriptionList) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *SubscriptionList) GetTotalYearlyCost() float64 {
+	if x != nil {
+		return x.TotalYearlyCost
+	}
+	return 0
+}
+
+type CreateDebtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Counterparty  string                 `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Direction     string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Principal     float64                `protobuf:"fixed64,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	AnnualRate    float64                `protobuf:"fixed64,5,opt,name=annual_rate,json=annualRate,proto3" json:"annual_rate,omitempty"`
+	StartDate     string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	TermMonths    int32                  `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	Payment       float64                `protobuf:"fixed64,8,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDebtRequest) Reset() {
+	*x = CreateDebtRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDebtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDebtRequest) ProtoMessage() {}
+
+func (x *CreateDebtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDebtRequest.ProtoReflect.Descriptor instead.
+func (*CreateDebtRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreateDebtRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateDebtRequest) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *CreateDebtRequest) GetAnnualRate() float64 {
+	if x != nil {
+		return x.AnnualRate
+	}
+	return 0
+}
+
+func (x *CreateDebtRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateDebtRequest) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *CreateDebtRequest) GetPayment() float64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+type DebtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	DebtId        int64                  `protobuf:"varint,3,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtResponse) Reset() {
+	*x = DebtResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtResponse) ProtoMessage() {}
+
+func (x *DebtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtResponse.ProtoReflect.Descriptor instead.
+func (*DebtResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DebtResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DebtResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DebtResponse) GetDebtId() int64 {
+	if x != nil {
+		return x.DebtId
+	}
+	return 0
+}
+
+type DebtPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DebtId        int64                  `protobuf:"varint,2,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtPaymentRequest) Reset() {
+	*x = DebtPaymentRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtPaymentRequest) ProtoMessage() {}
+
+func (x *DebtPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtPaymentRequest.ProtoReflect.Descriptor instead.
+func (*DebtPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DebtPaymentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DebtPaymentRequest) GetDebtId() int64 {
+	if x != nil {
+		return x.DebtId
+	}
+	return 0
+}
+
+func (x *DebtPaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DebtPaymentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DebtPaymentRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ListDebtsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDebtsRequest) Reset() {
+	*x = ListDebtsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDebtsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDebtsRequest) ProtoMessage() {}
+
+func (x *ListDebtsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDebtsRequest.ProtoReflect.Descriptor instead.
+func (*ListDebtsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListDebtsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Debt struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DebtId          int64                  `protobuf:"varint,1,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	Counterparty    string                 `protobuf:"bytes,2,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	Direction       string                 `protobuf:"bytes,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Principal       float64                `protobuf:"fixed64,4,opt,name=principal,proto3" json:"principal,omitempty"`
+	AnnualRate      float64                `protobuf:"fixed64,5,opt,name=annual_rate,json=annualRate,proto3" json:"annual_rate,omitempty"`
+	StartDate       string                 `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	TermMonths      int32                  `protobuf:"varint,7,opt,name=term_months,json=termMonths,proto3" json:"term_months,omitempty"`
+	Payment         float64                `protobuf:"fixed64,8,opt,name=payment,proto3" json:"payment,omitempty"`
+	PrincipalPaid   float64                `protobuf:"fixed64,9,opt,name=principal_paid,json=principalPaid,proto3" json:"principal_paid,omitempty"`
+	InterestPaid    float64                `protobuf:"fixed64,10,opt,name=interest_paid,json=interestPaid,proto3" json:"interest_paid,omitempty"`
+	Balance         float64                `protobuf:"fixed64,11,opt,name=balance,proto3" json:"balance,omitempty"`
+	AccruedInterest float64                `protobuf:"fixed64,12,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"`
+	Outstanding     float64                `protobuf:"fixed64,13,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Debt) Reset() {
+	*x = Debt{}
+	mi := &file_proto_ledger_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Debt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Debt) ProtoMessage() {}
+
+func (x *Debt) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Debt.ProtoReflect.Descriptor instead.
+func (*Debt) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Debt) GetDebtId() int64 {
+	if x != nil {
+		return x.DebtId
+	}
+	return 0
+}
+
+func (x *Debt) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+func (x *Debt) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *Debt) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *Debt) GetAnnualRate() float64 {
+	if x != nil {
+		return x.AnnualRate
+	}
+	return 0
+}
+
+func (x *Debt) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Debt) GetTermMonths() int32 {
+	if x != nil {
+		return x.TermMonths
+	}
+	return 0
+}
+
+func (x *Debt) GetPayment() float64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+func (x *Debt) GetPrincipalPaid() float64 {
+	if x != nil {
+		return x.PrincipalPaid
+	}
+	return 0
+}
+
+func (x *Debt) GetInterestPaid() float64 {
+	if x != nil {
+		return x.InterestPaid
+	}
+	return 0
+}
+
+func (x *Debt) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Debt) GetAccruedInterest() float64 {
+	if x != nil {
+		return x.AccruedInterest
+	}
+	return 0
+}
+
+func (x *Debt) GetOutstanding() float64 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+type DebtList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debts         []*Debt                `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtList) Reset() {
+	*x = DebtList{}
+	mi := &file_proto_ledger_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtList) ProtoMessage() {}
+
+func (x *DebtList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtList.ProtoReflect.Descriptor instead.
+func (*DebtList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DebtList) GetDebts() []*Debt {
+	if x != nil {
+		return x.Debts
+	}
+	return nil
+}
+
+type DebtScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DebtId        int64                  `protobuf:"varint,2,opt,name=debt_id,json=debtId,proto3" json:"debt_id,omitempty"`
+	ExtraPayments []float64              `protobuf:"fixed64,3,rep,packed,name=extra_payments,json=extraPayments,proto3" json:"extra_payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtScheduleRequest) Reset() {
+	*x = DebtScheduleRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtScheduleRequest) ProtoMessage() {}
+
+func (x *DebtScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DebtScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DebtScheduleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DebtScheduleRequest) GetDebtId() int64 {
+	if x != nil {
+		return x.DebtId
+	}
+	return 0
+}
+
+func (x *DebtScheduleRequest) GetExtraPayments() []float64 {
+	if x != nil {
+		return x.ExtraPayments
+	}
+	return nil
+}
+
+type AmortisationRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	N             int32                  `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Payment       float64                `protobuf:"fixed64,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	Interest      float64                `protobuf:"fixed64,4,opt,name=interest,proto3" json:"interest,omitempty"`
+	Principal     float64                `protobuf:"fixed64,5,opt,name=principal,proto3" json:"principal,omitempty"`
+	Balance       float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmortisationRow) Reset() {
+	*x = AmortisationRow{}
+	mi := &file_proto_ledger_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmortisationRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmortisationRow) ProtoMessage() {}
+
+func (x *AmortisationRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmortisationRow.ProtoReflect.Descriptor instead.
+func (*AmortisationRow) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AmortisationRow) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *AmortisationRow) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *AmortisationRow) GetPayment() float64 {
+	if x != nil {
+		return x.Payment
+	}
+	return 0
+}
+
+func (x *AmortisationRow) GetInterest() float64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *AmortisationRow) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *AmortisationRow) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type PayoffProjection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExtraPayment  float64                `protobuf:"fixed64,1,opt,name=extra_payment,json=extraPayment,proto3" json:"extra_payment,omitempty"`
+	PaysOff       bool                   `protobuf:"varint,2,opt,name=pays_off,json=paysOff,proto3" json:"pays_off,omitempty"`
+	PayoffDate    string                 `protobuf:"bytes,3,opt,name=payoff_date,json=payoffDate,proto3" json:"payoff_date,omitempty"`
+	Months        int32                  `protobuf:"varint,4,opt,name=months,proto3" json:"months,omitempty"`
+	TotalInterest float64                `protobuf:"fixed64,5,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	MonthsSaved   int32                  `protobuf:"varint,6,opt,name=months_saved,json=monthsSaved,proto3" json:"months_saved,omitempty"`
+	InterestSaved float64                `protobuf:"fixed64,7,opt,name=interest_saved,json=interestSaved,proto3" json:"interest_saved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayoffProjection) Reset() {
+	*x = PayoffProjection{}
+	mi := &file_proto_ledger_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayoffProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoffProjection) ProtoMessage() {}
+
+func (x *PayoffProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayoffProjection.ProtoReflect.Descriptor instead.
+func (*PayoffProjection) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *PayoffProjection) GetExtraPayment() float64 {
+	if x != nil {
+		return x.ExtraPayment
+	}
+	return 0
+}
+
+func (x *PayoffProjection) GetPaysOff() bool {
+	if x != nil {
+		return x.PaysOff
+	}
+	return false
+}
+
+func (x *PayoffProjection) GetPayoffDate() string {
+	if x != nil {
+		return x.PayoffDate
+	}
+	return ""
+}
+
+func (x *PayoffProjection) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *PayoffProjection) GetTotalInterest() float64 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *PayoffProjection) GetMonthsSaved() int32 {
+	if x != nil {
+		return x.MonthsSaved
+	}
+	return 0
+}
+
+func (x *PayoffProjection) GetInterestSaved() float64 {
+	if x != nil {
+		return x.InterestSaved
+	}
+	return 0
+}
+
+type DebtSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debt          *Debt                  `protobuf:"bytes,1,opt,name=debt,proto3" json:"debt,omitempty"`
+	Schedule      []*AmortisationRow     `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	Projections   []*PayoffProjection    `protobuf:"bytes,3,rep,name=projections,proto3" json:"projections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtSchedule) Reset() {
+	*x = DebtSchedule{}
+	mi := &file_proto_ledger_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtSchedule) ProtoMessage() {}
+
+func (x *DebtSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtSchedule.ProtoReflect.Descriptor instead.
+func (*DebtSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DebtSchedule) GetDebt() *Debt {
+	if x != nil {
+		return x.Debt
+	}
+	return nil
+}
+
+func (x *DebtSchedule) GetSchedule() []*AmortisationRow {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *DebtSchedule) GetProjections() []*PayoffProjection {
+	if x != nil {
+		return x.Projections
+	}
+	return nil
+}
+
+type IncomeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncomeRequest) Reset() {
+	*x = IncomeRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeRequest) ProtoMessage() {}
+
+func (x *IncomeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeRequest.ProtoReflect.Descriptor instead.
+func (*IncomeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *IncomeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *IncomeRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *IncomeRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *IncomeRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *IncomeRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type EnvelopeMoveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FromCategory  string                 `protobuf:"bytes,2,opt,name=from_category,json=fromCategory,proto3" json:"from_category,omitempty"`
+	ToCategory    string                 `protobuf:"bytes,3,opt,name=to_category,json=toCategory,proto3" json:"to_category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvelopeMoveRequest) Reset() {
+	*x = EnvelopeMoveRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvelopeMoveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvelopeMoveRequest) ProtoMessage() {}
+
+func (x *EnvelopeMoveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvelopeMoveRequest.ProtoReflect.Descriptor instead.
+func (*EnvelopeMoveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *EnvelopeMoveRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *EnvelopeMoveRequest) GetFromCategory() string {
+	if x != nil {
+		return x.FromCategory
+	}
+	return ""
+}
+
+func (x *EnvelopeMoveRequest) GetToCategory() string {
+	if x != nil {
+		return x.ToCategory
+	}
+	return ""
+}
+
+func (x *EnvelopeMoveRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EnvelopeMoveRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type EnvelopeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MoveId        int64                  `protobuf:"varint,3,opt,name=move_id,json=moveId,proto3" json:"move_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvelopeResponse) Reset() {
+	*x = EnvelopeResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvelopeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvelopeResponse) ProtoMessage() {}
+
+func (x *EnvelopeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvelopeResponse.ProtoReflect.Descriptor instead.
+func (*EnvelopeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *EnvelopeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EnvelopeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EnvelopeResponse) GetMoveId() int64 {
+	if x != nil {
+		return x.MoveId
+	}
+	return 0
+}
+
+type GetEnvelopesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEnvelopesRequest) Reset() {
+	*x = GetEnvelopesRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEnvelopesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnvelopesRequest) ProtoMessage() {}
+
+func (x *GetEnvelopesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnvelopesRequest.ProtoReflect.Descriptor instead.
+func (*GetEnvelopesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetEnvelopesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type Envelope struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Allocated     float64                `protobuf:"fixed64,2,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Spent         float64                `protobuf:"fixed64,3,opt,name=spent,proto3" json:"spent,omitempty"`
+	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	mi := &file_proto_ledger_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Envelope) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Envelope) GetAllocated() float64 {
+	if x != nil {
+		return x.Allocated
+	}
+	return 0
+}
+
+func (x *Envelope) GetSpent() float64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
+
+func (x *Envelope) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type EnvelopeMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromCategory  string                 `protobuf:"bytes,2,opt,name=from_category,json=fromCategory,proto3" json:"from_category,omitempty"`
+	ToCategory    string                 `protobuf:"bytes,3,opt,name=to_category,json=toCategory,proto3" json:"to_category,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvelopeMove) Reset() {
+	*x = EnvelopeMove{}
+	mi := &file_proto_ledger_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvelopeMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvelopeMove) ProtoMessage() {}
+
+func (x *EnvelopeMove) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvelopeMove.ProtoReflect.Descriptor instead.
+func (*EnvelopeMove) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *EnvelopeMove) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EnvelopeMove) GetFromCategory() string {
+	if x != nil {
+		return x.FromCategory
+	}
+	return ""
+}
+
+func (x *EnvelopeMove) GetToCategory() string {
+	if x != nil {
+		return x.ToCategory
+	}
+	return ""
+}
+
+func (x *EnvelopeMove) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EnvelopeMove) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *EnvelopeMove) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type EnvelopeSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         string                 `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	Income        float64                `protobuf:"fixed64,2,opt,name=income,proto3" json:"income,omitempty"`
+	Unassigned    float64                `protobuf:"fixed64,3,opt,name=unassigned,proto3" json:"unassigned,omitempty"`
+	Envelopes     []*Envelope            `protobuf:"bytes,4,rep,name=envelopes,proto3" json:"envelopes,omitempty"`
+	Moves         []*EnvelopeMove        `protobuf:"bytes,5,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvelopeSummary) Reset() {
+	*x = EnvelopeSummary{}
+	mi := &file_proto_ledger_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvelopeSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvelopeSummary) ProtoMessage() {}
+
+func (x *EnvelopeSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvelopeSummary.ProtoReflect.Descriptor instead.
+func (*EnvelopeSummary) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *EnvelopeSummary) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *EnvelopeSummary) GetIncome() float64 {
+	if x != nil {
+		return x.Income
+	}
+	return 0
+}
+
+func (x *EnvelopeSummary) GetUnassigned() float64 {
+	if x != nil {
+		return x.Unassigned
+	}
+	return 0
+}
+
+func (x *EnvelopeSummary) GetEnvelopes() []*Envelope {
+	if x != nil {
+		return x.Envelopes
+	}
+	return nil
+}
+
+func (x *EnvelopeSummary) GetMoves() []*EnvelopeMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type CreateExpenseReportRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TransactionIds []int64                `protobuf:"varint,3,rep,packed,name=transaction_ids,json=transactionIds,proto3" json:"transaction_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateExpenseReportRequest) Reset() {
+	*x = CreateExpenseReportRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseReportRequest) ProtoMessage() {}
+
+func (x *CreateExpenseReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseReportRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateExpenseReportRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateExpenseReportRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateExpenseReportRequest) GetTransactionIds() []int64 {
+	if x != nil {
+		return x.TransactionIds
+	}
+	return nil
+}
+
+type ExpenseReportStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReportId      int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseReportStatusRequest) Reset() {
+	*x = ExpenseReportStatusRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReportStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReportStatusRequest) ProtoMessage() {}
+
+func (x *ExpenseReportStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReportStatusRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseReportStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ExpenseReportStatusRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExpenseReportStatusRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ExpenseReportStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ExpenseReportItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReportId      int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseReportItemRequest) Reset() {
+	*x = ExpenseReportItemRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReportItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReportItemRequest) ProtoMessage() {}
+
+func (x *ExpenseReportItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReportItemRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseReportItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ExpenseReportItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ExpenseReportItemRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ExpenseReportItemRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+type ReimburseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReportId      int64                  `protobuf:"varint,2,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReimburseRequest) Reset() {
+	*x = ReimburseRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReimburseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReimburseRequest) ProtoMessage() {}
+
+func (x *ReimburseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReimburseRequest.ProtoReflect.Descriptor instead.
+func (*ReimburseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ReimburseRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReimburseRequest) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ReimburseRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ReimburseRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ListExpenseReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpenseReportsRequest) Reset() {
+	*x = ListExpenseReportsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpenseReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpenseReportsRequest) ProtoMessage() {}
+
+func (x *ListExpenseReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpenseReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpenseReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ListExpenseReportsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListExpenseReportsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ExpenseItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseItem) Reset() {
+	*x = ExpenseItem{}
+	mi := &file_proto_ledger_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseItem) ProtoMessage() {}
+
+func (x *ExpenseItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseItem.ProtoReflect.Descriptor instead.
+func (*ExpenseItem) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ExpenseItem) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *ExpenseItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ExpenseItem) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ExpenseItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExpenseItem) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ExpenseReport struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReportId         int64                  `protobuf:"varint,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Title            string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Total            float64                `protobuf:"fixed64,4,opt,name=total,proto3" json:"total,omitempty"`
+	ReimbursedAmount float64                `protobuf:"fixed64,5,opt,name=reimbursed_amount,json=reimbursedAmount,proto3" json:"reimbursed_amount,omitempty"`
+	ReimbursementId  int64                  `protobuf:"varint,6,opt,name=reimbursement_id,json=reimbursementId,proto3" json:"reimbursement_id,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SubmittedAt      string                 `protobuf:"bytes,8,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ApprovedAt       string                 `protobuf:"bytes,9,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	PaidAt           string                 `protobuf:"bytes,10,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	Items            []*ExpenseItem         `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ExpenseReport) Reset() {
+	*x = ExpenseReport{}
+	mi := &file_proto_ledger_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReport) ProtoMessage() {}
+
+func (x *ExpenseReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReport.ProtoReflect.Descriptor instead.
+func (*ExpenseReport) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ExpenseReport) GetReportId() int64 {
+	if x != nil {
+		return x.ReportId
+	}
+	return 0
+}
+
+func (x *ExpenseReport) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ExpenseReport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ExpenseReport) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ExpenseReport) GetReimbursedAmount() float64 {
+	if x != nil {
+		return x.ReimbursedAmount
+	}
+	return 0
+}
+
+func (x *ExpenseReport) GetReimbursementId() int64 {
+	if x != nil {
+		return x.ReimbursementId
+	}
+	return 0
+}
+
+func (x *ExpenseReport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ExpenseReport) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *ExpenseReport) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *ExpenseReport) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *ExpenseReport) GetItems() []*ExpenseItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ExpenseReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Report        *ExpenseReport         `protobuf:"bytes,3,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseReportResponse) Reset() {
+	*x = ExpenseReportResponse{}
+	mi := &file_proto_ledger_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReportResponse) ProtoMessage() {}
+
+func (x *ExpenseReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReportResponse.ProtoReflect.Descriptor instead.
+func (*ExpenseReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ExpenseReportResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExpenseReportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExpenseReportResponse) GetReport() *ExpenseReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type ExpenseReportList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*ExpenseReport       `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseReportList) Reset() {
+	*x = ExpenseReportList{}
+	mi := &file_proto_ledger_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReportList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReportList) ProtoMessage() {}
+
+func (x *ExpenseReportList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReportList.ProtoReflect.Descriptor instead.
+func (*ExpenseReportList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ExpenseReportList) GetReports() []*ExpenseReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type RefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransactionId int64                  `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundRequest) Reset() {
+	*x = RefundRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundRequest) ProtoMessage() {}
+
+func (x *RefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundRequest.ProtoReflect.Descriptor instead.
+func (*RefundRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *RefundRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ListTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransactionsRequest) Reset() {
+	*x = ListTransactionsRequest{}
+	mi := &file_proto_ledger_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransactionsRequest) ProtoMessage() {}
+
+func (x *ListTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ListTransactionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListTransactionsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ListTransactionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId   int64                  `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount          float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Category        string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	OccurredAt      string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Kind            string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Tags            []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	RefundOf        int64                  `protobuf:"varint,8,opt,name=refund_of,json=refundOf,proto3" json:"refund_of,omitempty"`
+	AppliesAt       string                 `protobuf:"bytes,9,opt,name=applies_at,json=appliesAt,proto3" json:"applies_at,omitempty"`
+	RefundedAmount  float64                `protobuf:"fixed64,10,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	Reimbursable    bool                   `protobuf:"varint,11,opt,name=reimbursable,proto3" json:"reimbursable,omitempty"`
+	Reimbursed      bool                   `protobuf:"varint,12,opt,name=reimbursed,proto3" json:"reimbursed,omitempty"`
+	ExpenseReportId int64                  `protobuf:"varint,13,opt,name=expense_report_id,json=expenseReportId,proto3" json:"expense_report_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_proto_ledger_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *LedgerEntry) GetTransactionId() int64 {
+	if x != nil {
+		return x.TransactionId
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetRefundOf() int64 {
+	if x != nil {
+		return x.RefundOf
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetAppliesAt() string {
+	if x != nil {
+		return x.AppliesAt
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetRefundedAmount() float64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetReimbursable() bool {
+	if x != nil {
+		return x.Reimbursable
+	}
+	return false
+}
+
+func (x *LedgerEntry) GetReimbursed() bool {
+	if x != nil {
+		return x.Reimbursed
+	}
+	return false
+}
+
+func (x *LedgerEntry) GetExpenseReportId() int64 {
+	if x != nil {
+		return x.ExpenseReportId
+	}
+	return 0
+}
+
+type TransactionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*LedgerEntry         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionList) Reset() {
+	*x = TransactionList{}
+	mi := &file_proto_ledger_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionList) ProtoMessage() {}
+
+func (x *TransactionList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ledger_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionList.ProtoReflect.Descriptor instead.
+func (*TransactionList) Descriptor() ([]byte, []int) {
+	return file_proto_ledger_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *TransactionList) GetTransactions() []*LedgerEntry {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+var File_proto_ledger_proto protoreflect.FileDescriptor
+
+const file_proto_ledger_proto_rawDesc = "" +
+	"\n" +
+	"\x12proto/ledger.proto\x12\tpb_ledger\"\x85\x02\n" +
+	"\x12TransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12\x1f\n" +
+	"\voccurred_at\x18\x06 \x01(\tR\n" +
+	"occurredAt\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\"\n" +
+	"\freimbursable\x18\b \x01(\bR\freimbursable\"\xbe\x01\n" +
+	"\x13TransactionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\x03R\rtransactionId\x12#\n" +
+	"\ranomaly_score\x18\x04 \x01(\x01R\fanomalyScore\x12'\n" +
+	"\x0fanomaly_reasons\x18\x05 \x03(\tR\x0eanomalyReasons\"(\n" +
+	"\rReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xbc\x01\n" +
+	"\x0eReportResponse\x12\x1f\n" +
+	"\vtotal_spend\x18\x01 \x01(\x01R\n" +
+	"totalSpend\x12J\n" +
+	"\vby_category\x18\x02 \x03(\v2).pb_ledger.ReportResponse.ByCategoryEntryR\n" +
+	"byCategory\x1a=\n" +
+	"\x0fByCategoryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"\xcd\x01\n" +
+	"\rBudgetRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12!\n" +
+	"\flimit_amount\x18\x03 \x01(\x01R\vlimitAmount\x12\x1a\n" +
+	"\brollover\x18\x04 \x01(\tR\brollover\x12!\n" +
+	"\frollover_cap\x18\x05 \x01(\x01R\vrolloverCap\x12%\n" +
+	"\x0eeffective_from\x18\x06 \x01(\tR\reffectiveFrom\"D\n" +
+	"\x0eBudgetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\",\n" +
+	"\x11GetBudgetsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xf0\x01\n" +
+	"\x06Budget\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12!\n" +
+	"\flimit_amount\x18\x02 \x01(\x01R\vlimitAmount\x12\x1a\n" +
+	"\brollover\x18\x03 \x01(\tR\brollover\x12!\n" +
+	"\frollover_cap\x18\x04 \x01(\x01R\vrolloverCap\x12\x1c\n" +
+	"\tcarryover\x18\x05 \x01(\x01R\tcarryover\x12'\n" +
+	"\x0feffective_limit\x18\x06 \x01(\x01R\x0eeffectiveLimit\x12!\n" +
+	"\fperiod_start\x18\a \x01(\tR\vperiodStart\"9\n" +
+	"\n" +
+	"BudgetList\x12+\n" +
+	"\abudgets\x18\x01 \x03(\v2\x11.pb_ledger.BudgetR\abudgets\"K\n" +
+	"\x14BudgetHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"\xc5\x01\n" +
+	"\fBudgetPeriod\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"base_limit\x18\x02 \x01(\x01R\tbaseLimit\x12\x1c\n" +
+	"\tcarryover\x18\x03 \x01(\x01R\tcarryover\x12'\n" +
+	"\x0feffective_limit\x18\x04 \x01(\x01R\x0eeffectiveLimit\x12\x14\n" +
+	"\x05spent\x18\x05 \x01(\x01R\x05spent\x12\x16\n" +
+	"\x06closed\x18\x06 \x01(\bR\x06closed\"^\n" +
+	"\rBudgetHistory\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x121\n" +
+	"\aperiods\x18\x02 \x03(\v2\x17.pb_ledger.BudgetPeriodR\aperiods\"J\n" +
+	"\x13BudgetLimitsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\"\x92\x01\n" +
+	"\vBudgetLimit\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12!\n" +
+	"\flimit_amount\x18\x02 \x01(\x01R\vlimitAmount\x12%\n" +
+	"\x0eeffective_from\x18\x03 \x01(\tR\reffectiveFrom\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"A\n" +
+	"\x0fBudgetLimitList\x12.\n" +
+	"\x06limits\x18\x01 \x03(\v2\x16.pb_ledger.BudgetLimitR\x06limits\"S\n" +
+	"\x12BudgetTemplateItem\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12!\n" +
+	"\flimit_amount\x18\x02 \x01(\x01R\vlimitAmount\"\x9c\x01\n" +
+	"\x15BudgetTemplateRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.pb_ledger.BudgetTemplateItemR\x05items\x12!\n" +
+	"\ffrom_current\x18\x04 \x01(\bR\vfromCurrent\"m\n" +
+	"\x16BudgetTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vtemplate_id\x18\x03 \x01(\x03R\n" +
+	"templateId\"5\n" +
+	"\x1aListBudgetTemplatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\x99\x01\n" +
+	"\x0eBudgetTemplate\x12\x1f\n" +
+	"\vtemplate_id\x18\x01 \x01(\x03R\n" +
+	"templateId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\x05items\x18\x03 \x03(\v2\x1d.pb_ledger.BudgetTemplateItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\"M\n" +
+	"\x12BudgetTemplateList\x127\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x19.pb_ledger.BudgetTemplateR\ttemplates\"\xa3\x01\n" +
+	"\x13ApplyBudgetsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12%\n" +
+	"\x0eadjust_percent\x18\x04 \x01(\x01R\radjustPercent\x12\x18\n" +
+	"\apreview\x18\x05 \x01(\bR\apreview\"\x8b\x01\n" +
+	"\fBudgetChange\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1b\n" +
+	"\thad_limit\x18\x02 \x01(\bR\bhadLimit\x12%\n" +
+	"\x0eprevious_limit\x18\x03 \x01(\x01R\rpreviousLimit\x12\x1b\n" +
+	"\tnew_limit\x18\x04 \x01(\x01R\bnewLimit\"\xc7\x01\n" +
+	"\x14ApplyBudgetsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12\x18\n" +
+	"\aapplied\x18\x05 \x01(\bR\aapplied\x121\n" +
+	"\achanges\x18\x06 \x03(\v2\x17.pb_ledger.BudgetChangeR\achanges\"c\n" +
+	"\x14CategoryGroupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\tR\n" +
+	"categories\"K\n" +
+	"\x15CategoryGroupResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"4\n" +
+	"\x19ListCategoryGroupsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"C\n" +
+	"\rCategoryGroup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\tR\n" +
+	"categories\"E\n" +
+	"\x11CategoryGroupList\x120\n" +
+	"\x06groups\x18\x01 \x03(\v2\x18.pb_ledger.CategoryGroupR\x06groups\"*\n" +
+	"\x0fForecastRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xfc\x01\n" +
+	"\x10CategoryForecast\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05spent\x18\x02 \x01(\x01R\x05spent\x12\x1c\n" +
+	"\tprojected\x18\x03 \x01(\x01R\tprojected\x12+\n" +
+	"\x11recurring_pending\x18\x04 \x01(\x01R\x10recurringPending\x12!\n" +
+	"\flimit_amount\x18\x05 \x01(\x01R\vlimitAmount\x12\x1d\n" +
+	"\n" +
+	"has_budget\x18\x06 \x01(\bR\thasBudget\x12)\n" +
+	"\x10likely_overspend\x18\a \x01(\bR\x0flikelyOverspend\"\xd3\x01\n" +
+	"\x10ForecastResponse\x12!\n" +
+	"\fperiod_start\x18\x01 \x01(\tR\vperiodStart\x12\x1d\n" +
+	"\n" +
+	"period_end\x18\x02 \x01(\tR\tperiodEnd\x12!\n" +
+	"\fdays_elapsed\x18\x03 \x01(\x05R\vdaysElapsed\x12\x1d\n" +
+	"\n" +
+	"days_total\x18\x04 \x01(\x05R\tdaysTotal\x12;\n" +
+	"\n" +
+	"categories\x18\x05 \x03(\v2\x1b.pb_ledger.CategoryForecastR\n" +
+	"categories\"Z\n" +
+	"\x14ListAnomaliesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
+	"\x10include_reviewed\x18\x02 \x01(\bR\x0fincludeReviewed\"\x81\x02\n" +
+	"\aAnomaly\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\x12\x18\n" +
+	"\areasons\x18\a \x03(\tR\areasons\x12\x1a\n" +
+	"\breviewed\x18\b \x01(\bR\breviewed\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"?\n" +
+	"\vAnomalyList\x120\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x12.pb_ledger.AnomalyR\tanomalies\"?\n" +
+	"\x14ReviewAnomalyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"K\n" +
+	"\x15ReviewAnomalyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"?\n" +
+	"\x0eCompareRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\tR\x05month\"\xf6\x02\n" +
+	"\x12CategoryComparison\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x18\n" +
+	"\acurrent\x18\x02 \x01(\x01R\acurrent\x12\x1a\n" +
+	"\bprevious\x18\x03 \x01(\x01R\bprevious\x12\x19\n" +
+	"\byear_ago\x18\x04 \x01(\x01R\ayearAgo\x12'\n" +
+	"\x0fchange_previous\x18\x05 \x01(\x01R\x0echangePrevious\x12.\n" +
+	"\x13change_previous_pct\x18\x06 \x01(\x01R\x11changePreviousPct\x12&\n" +
+	"\x0fchange_year_ago\x18\a \x01(\x01R\rchangeYearAgo\x12-\n" +
+	"\x13change_year_ago_pct\x18\b \x01(\x01R\x10changeYearAgoPct\x12!\n" +
+	"\fnew_previous\x18\t \x01(\bR\vnewPrevious\x12 \n" +
+	"\fnew_year_ago\x18\n" +
+	" \x01(\bR\n" +
+	"newYearAgo\"\xae\x02\n" +
+	"\x0fCompareResponse\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12%\n" +
+	"\x0eprevious_month\x18\x02 \x01(\tR\rpreviousMonth\x12$\n" +
+	"\x0eyear_ago_month\x18\x03 \x01(\tR\fyearAgoMonth\x12=\n" +
+	"\n" +
+	"categories\x18\x04 \x03(\v2\x1d.pb_ledger.CategoryComparisonR\n" +
+	"categories\x12<\n" +
+	"\n" +
+	"top_movers\x18\x05 \x03(\v2\x1d.pb_ledger.CategoryComparisonR\ttopMovers\x12;\n" +
+	"\tnewcomers\x18\x06 \x03(\v2\x1d.pb_ledger.CategoryComparisonR\tnewcomers\"a\n" +
+	"\fPivotRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"from_month\x18\x02 \x01(\tR\tfromMonth\x12\x19\n" +
+	"\bto_month\x18\x03 \x01(\tR\atoMonth\"T\n" +
+	"\bPivotRow\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\x01R\x06values\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\"\x96\x01\n" +
+	"\vPivotReport\x12\x18\n" +
+	"\aperiods\x18\x01 \x03(\tR\aperiods\x12'\n" +
+	"\x04rows\x18\x02 \x03(\v2\x13.pb_ledger.PivotRowR\x04rows\x12#\n" +
+	"\rcolumn_totals\x18\x03 \x03(\x01R\fcolumnTotals\x12\x1f\n" +
+	"\vgrand_total\x18\x04 \x01(\x01R\n" +
+	"grandTotal\"P\n" +
+	"\x11StatisticsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xb9\x01\n" +
+	"\rCategoryStats\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\x12\x12\n" +
+	"\x04mean\x18\x04 \x01(\x01R\x04mean\x12\x16\n" +
+	"\x06median\x18\x05 \x01(\x01R\x06median\x12\x10\n" +
+	"\x03min\x18\x06 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\a \x01(\x01R\x03max\x12\x10\n" +
+	"\x03p90\x18\b \x01(\x01R\x03p90\"n\n" +
+	"\fWeekdayStats\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\x12\x18\n" +
+	"\aaverage\x18\x04 \x01(\x01R\aaverage\"\x83\x02\n" +
+	"\x12StatisticsResponse\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x03R\x05count\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x01R\x05total\x12.\n" +
+	"\x13average_daily_spend\x18\x05 \x01(\x01R\x11averageDailySpend\x128\n" +
+	"\n" +
+	"categories\x18\x06 \x03(\v2\x18.pb_ledger.CategoryStatsR\n" +
+	"categories\x123\n" +
+	"\bweekdays\x18\a \x03(\v2\x17.pb_ledger.WeekdayStatsR\bweekdays\"-\n" +
+	"\x12GetSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"z\n" +
+	"\fUserSettings\x12\x1a\n" +
+	"\btimezone\x18\x01 \x01(\tR\btimezone\x12\x1f\n" +
+	"\vbudget_mode\x18\x02 \x01(\tR\n" +
+	"budgetMode\x12-\n" +
+	"\x12refund_attribution\x18\x03 \x01(\tR\x11refundAttribution\"I\n" +
+	"\x12SetTimezoneRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\"P\n" +
+	"\x14SetBudgetModeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vbudget_mode\x18\x02 \x01(\tR\n" +
+	"budgetMode\"e\n" +
+	"\x1bSetRefundAttributionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12-\n" +
+	"\x12refund_attribution\x18\x02 \x01(\tR\x11refundAttribution\"F\n" +
+	"\x10SettingsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x9c\x01\n" +
+	"\x14BatchTransactionItem\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12?\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1d.pb_ledger.TransactionRequestR\vtransaction\x12\x16\n" +
+	"\x06atomic\x18\x03 \x01(\bR\x06atomic\x12\x19\n" +
+	"\brow_uuid\x18\x04 \x01(\tR\arowUuid\"\xcb\x01\n" +
+	"\x0eBatchRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\x03R\rtransactionId\x12#\n" +
+	"\ranomaly_score\x18\x05 \x01(\x01R\fanomalyScore\x12'\n" +
+	"\x0fanomaly_reasons\x18\x06 \x03(\tR\x0eanomalyReasons\"\x9d\x01\n" +
+	"\x18BatchTransactionResponse\x12\x1a\n" +
+	"\binserted\x18\x01 \x01(\x05R\binserted\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\x123\n" +
+	"\aresults\x18\x03 \x03(\v2\x19.pb_ledger.BatchRowResultR\aresults\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\"K\n" +
+	"\fWatchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\rlast_event_id\x18\x02 \x01(\tR\vlastEventId\"\xcd\x02\n" +
+	"\vLedgerEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\x12%\n" +
+	"\x0etransaction_id\x18\x04 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12!\n" +
+	"\flimit_amount\x18\b \x01(\x01R\vlimitAmount\x12\x14\n" +
+	"\x05spent\x18\t \x01(\x01R\x05spent\x12\x1c\n" +
+	"\tthreshold\x18\n" +
+	" \x01(\x01R\tthreshold\x12'\n" +
+	"\x0fprevious_amount\x18\v \x01(\x01R\x0epreviousAmount\"\xba\x01\n" +
+	"\x18UpdateTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x04 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x06 \x01(\tR\n" +
+	"occurredAt\"C\n" +
+	"\x18DeleteTransactionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\xa7\x02\n" +
+	"\aSyncRow\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x19\n" +
+	"\brow_uuid\x18\x03 \x01(\tR\arowUuid\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\a \x01(\tR\n" +
+	"occurredAt\x12\x18\n" +
+	"\adeleted\x18\b \x01(\bR\adeleted\x12\x18\n" +
+	"\aversion\x18\t \x01(\x05R\aversion\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"{\n" +
+	"\x0fSyncPushRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12&\n" +
+	"\x04rows\x18\x02 \x03(\v2\x12.pb_ledger.SyncRowR\x04rows\x12'\n" +
+	"\x0fconflict_policy\x18\x03 \x01(\tR\x0econflictPolicy\"\x7f\n" +
+	"\rSyncRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12*\n" +
+	"\x06server\x18\x04 \x01(\v2\x12.pb_ledger.SyncRowR\x06server\"F\n" +
+	"\x10SyncPushResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.pb_ledger.SyncRowResultR\aresults\"J\n" +
+	"\x0eChangesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vsince_token\x18\x02 \x01(\tR\n" +
+	"sinceToken\"}\n" +
+	"\x0fChangesResponse\x12,\n" +
+	"\achanges\x18\x01 \x03(\v2\x12.pb_ledger.SyncRowR\achanges\x12!\n" +
+	"\fchange_token\x18\x02 \x01(\tR\vchangeToken\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x81\x01\n" +
+	"\x11CreateGoalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rtarget_amount\x18\x03 \x01(\x01R\ftargetAmount\x12\x1a\n" +
+	"\bdeadline\x18\x04 \x01(\tR\bdeadline\"[\n" +
+	"\fGoalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\agoal_id\x18\x03 \x01(\x03R\x06goalId\"\xa2\x01\n" +
+	"\x13ContributionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\x03R\x06goalId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\"*\n" +
+	"\x0fGetGoalsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xab\x03\n" +
+	"\fGoalProgress\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\x03R\x06goalId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rtarget_amount\x18\x03 \x01(\x01R\ftargetAmount\x12\x1a\n" +
+	"\bdeadline\x18\x04 \x01(\tR\bdeadline\x12\x14\n" +
+	"\x05saved\x18\x05 \x01(\x01R\x05saved\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x01R\tremaining\x12\x18\n" +
+	"\apercent\x18\a \x01(\x01R\apercent\x12\x1f\n" +
+	"\vmonths_left\x18\b \x01(\x05R\n" +
+	"monthsLeft\x12)\n" +
+	"\x10required_monthly\x18\t \x01(\x01R\x0frequiredMonthly\x12'\n" +
+	"\x0faverage_monthly\x18\n" +
+	" \x01(\x01R\x0eaverageMonthly\x121\n" +
+	"\x14projected_completion\x18\v \x01(\tR\x13projectedCompletion\x12\x19\n" +
+	"\bon_track\x18\f \x01(\bR\aonTrack\x12\x1c\n" +
+	"\tcompleted\x18\r \x01(\bR\tcompleted\"9\n" +
+	"\bGoalList\x12-\n" +
+	"\x05goals\x18\x01 \x03(\v2\x17.pb_ledger.GoalProgressR\x05goals\"\xb5\x01\n" +
+	"\n" +
+	"Attachment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\x89\x01\n" +
+	"\x17UploadAttachmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"\x7f\n" +
+	"\x12AttachmentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\n" +
+	"attachment\x18\x03 \x01(\v2\x15.pb_ledger.AttachmentR\n" +
+	"attachment\"?\n" +
+	"\x14GetAttachmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\"\x8f\x01\n" +
+	"\x0eAttachmentData\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x125\n" +
+	"\n" +
+	"attachment\x18\x03 \x01(\v2\x15.pb_ledger.AttachmentR\n" +
+	"attachment\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"X\n" +
+	"\x16ListAttachmentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\"I\n" +
+	"\x0eAttachmentList\x127\n" +
+	"\vattachments\x18\x01 \x03(\v2\x15.pb_ledger.AttachmentR\vattachments\"w\n" +
+	"\x0eReceiptRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x0e\n" +
+	"\x02qr\x18\x02 \x01(\tR\x02qr\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\"g\n" +
+	"\x0fQuickAddRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\"\xb2\x02\n" +
+	"\x10QuickAddResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x05 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\a \x01(\tR\n" +
+	"occurredAt\x12#\n" +
+	"\ranomaly_score\x18\b \x01(\x01R\fanomalyScore\x12'\n" +
+	"\x0fanomaly_reasons\x18\t \x03(\tR\x0eanomalyReasons\"\xac\x01\n" +
+	"\rSearchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04from\x18\x04 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x05 \x01(\tR\x02to\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\a \x01(\x05R\x06offset\"\xef\x01\n" +
+	"\tSearchHit\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04rank\x18\a \x01(\x01R\x04rank\x12\x1c\n" +
+	"\thighlight\x18\b \x01(\tR\thighlight\":\n" +
+	"\x0eSearchResponse\x12(\n" +
+	"\x04hits\x18\x01 \x03(\v2\x14.pb_ledger.SearchHitR\x04hits\"H\n" +
+	"\bMerchant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\"^\n" +
+	"\x15CreateMerchantRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x03 \x03(\tR\aaliases\"j\n" +
+	"\x14MerchantAliasRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vmerchant_id\x18\x02 \x01(\x03R\n" +
+	"merchantId\x12\x18\n" +
+	"\apattern\x18\x03 \x01(\tR\apattern\"g\n" +
+	"\x10MerchantResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
+	"\vmerchant_id\x18\x03 \x01(\x03R\n" +
+	"merchantId\"/\n" +
+	"\x14ListMerchantsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"A\n" +
+	"\fMerchantList\x121\n" +
+	"\tmerchants\x18\x01 \x03(\v2\x13.pb_ledger.MerchantR\tmerchants\"h\n" +
+	"\x13TopMerchantsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x8c\x01\n" +
+	"\rMerchantStats\x12\x1f\n" +
+	"\vmerchant_id\x18\x01 \x01(\x03R\n" +
+	"merchantId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x01R\x05total\x12\x16\n" +
+	"\x06visits\x18\x04 \x01(\x05R\x06visits\x12\x18\n" +
+	"\aaverage\x18\x05 \x01(\x01R\aaverage\"r\n" +
+	"\x14TopMerchantsResponse\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x126\n" +
+	"\tmerchants\x18\x03 \x03(\v2\x18.pb_ledger.MerchantStatsR\tmerchants\"M\n" +
+	"\x18ListSubscriptionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\arefresh\x18\x02 \x01(\bR\arefresh\"\xc8\x02\n" +
+	"\fSubscription\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x18\n" +
+	"\acadence\x18\x03 \x01(\tR\acadence\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12 \n" +
+	"\voccurrences\x18\x05 \x01(\x05R\voccurrences\x12\x1f\n" +
+	"\vlast_charge\x18\x06 \x01(\tR\n" +
+	"lastCharge\x12\x1f\n" +
+	"\vnext_charge\x18\a \x01(\tR\n" +
+	"nextCharge\x12\x1f\n" +
+	"\vyearly_cost\x18\b \x01(\x01R\n" +
+	"yearlyCost\x12'\n" +
+	"\x0fprevious_amount\x18\t \x01(\x01R\x0epreviousAmount\x12(\n" +
+	"\x10price_changed_at\x18\n" +
+	" \x01(\tR\x0epriceChangedAt\"}\n" +
+	"\x10SubscriptionList\x12=\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x17.pb_ledger.SubscriptionR\rsubscriptions\x12*\n" +
+	"\x11total_yearly_cost\x18\x02 \x01(\x01R\x0ftotalYearlyCost\"\x87\x02\n" +
+	"\x11CreateDebtRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\fcounterparty\x18\x02 \x01(\tR\fcounterparty\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x1c\n" +
+	"\tprincipal\x18\x04 \x01(\x01R\tprincipal\x12\x1f\n" +
+	"\vannual_rate\x18\x05 \x01(\x01R\n" +
+	"annualRate\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x1f\n" +
+	"\vterm_months\x18\a \x01(\x05R\n" +
+	"termMonths\x12\x18\n" +
+	"\apayment\x18\b \x01(\x01R\apayment\"[\n" +
+	"\fDebtResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\adebt_id\x18\x03 \x01(\x03R\x06debtId\"\xa1\x01\n" +
+	"\x12DebtPaymentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\adebt_id\x18\x02 \x01(\x03R\x06debtId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\"+\n" +
+	"\x10ListDebtsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\xad\x03\n" +
+	"\x04Debt\x12\x17\n" +
+	"\adebt_id\x18\x01 \x01(\x03R\x06debtId\x12\"\n" +
+	"\fcounterparty\x18\x02 \x01(\tR\fcounterparty\x12\x1c\n" +
+	"\tdirection\x18\x03 \x01(\tR\tdirection\x12\x1c\n" +
+	"\tprincipal\x18\x04 \x01(\x01R\tprincipal\x12\x1f\n" +
+	"\vannual_rate\x18\x05 \x01(\x01R\n" +
+	"annualRate\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x1f\n" +
+	"\vterm_months\x18\a \x01(\x05R\n" +
+	"termMonths\x12\x18\n" +
+	"\apayment\x18\b \x01(\x01R\apayment\x12%\n" +
+	"\x0eprincipal_paid\x18\t \x01(\x01R\rprincipalPaid\x12#\n" +
+	"\rinterest_paid\x18\n" +
+	" \x01(\x01R\finterestPaid\x12\x18\n" +
+	"\abalance\x18\v \x01(\x01R\abalance\x12)\n" +
+	"\x10accrued_interest\x18\f \x01(\x01R\x0faccruedInterest\x12 \n" +
+	"\voutstanding\x18\r \x01(\x01R\voutstanding\"1\n" +
+	"\bDebtList\x12%\n" +
+	"\x05debts\x18\x01 \x03(\v2\x0f.pb_ledger.DebtR\x05debts\"n\n" +
+	"\x13DebtScheduleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\adebt_id\x18\x02 \x01(\x03R\x06debtId\x12%\n" +
+	"\x0eextra_payments\x18\x03 \x03(\x01R\rextraPayments\"\xa1\x01\n" +
+	"\x0fAmortisationRow\x12\f\n" +
+	"\x01n\x18\x01 \x01(\x05R\x01n\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x18\n" +
+	"\apayment\x18\x03 \x01(\x01R\apayment\x12\x1a\n" +
+	"\binterest\x18\x04 \x01(\x01R\binterest\x12\x1c\n" +
+	"\tprincipal\x18\x05 \x01(\x01R\tprincipal\x12\x18\n" +
+	"\abalance\x18\x06 \x01(\x01R\abalance\"\xfc\x01\n" +
+	"\x10PayoffProjection\x12#\n" +
+	"\rextra_payment\x18\x01 \x01(\x01R\fextraPayment\x12\x19\n" +
+	"\bpays_off\x18\x02 \x01(\bR\apaysOff\x12\x1f\n" +
+	"\vpayoff_date\x18\x03 \x01(\tR\n" +
+	"payoffDate\x12\x16\n" +
+	"\x06months\x18\x04 \x01(\x05R\x06months\x12%\n" +
+	"\x0etotal_interest\x18\x05 \x01(\x01R\rtotalInterest\x12!\n" +
+	"\fmonths_saved\x18\x06 \x01(\x05R\vmonthsSaved\x12%\n" +
+	"\x0einterest_saved\x18\a \x01(\x01R\rinterestSaved\"\xaa\x01\n" +
+	"\fDebtSchedule\x12#\n" +
+	"\x04debt\x18\x01 \x01(\v2\x0f.pb_ledger.DebtR\x04debt\x126\n" +
+	"\bschedule\x18\x02 \x03(\v2\x1a.pb_ledger.AmortisationRowR\bschedule\x12=\n" +
+	"\vprojections\x18\x03 \x03(\v2\x1b.pb_ledger.PayoffProjectionR\vprojections\"\x9f\x01\n" +
+	"\rIncomeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\"\xa0\x01\n" +
+	"\x13EnvelopeMoveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rfrom_category\x18\x02 \x01(\tR\ffromCategory\x12\x1f\n" +
+	"\vto_category\x18\x03 \x01(\tR\n" +
+	"toCategory\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"_\n" +
+	"\x10EnvelopeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\amove_id\x18\x03 \x01(\x03R\x06moveId\".\n" +
+	"\x13GetEnvelopesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"t\n" +
+	"\bEnvelope\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x1c\n" +
+	"\tallocated\x18\x02 \x01(\x01R\tallocated\x12\x14\n" +
+	"\x05spent\x18\x03 \x01(\x01R\x05spent\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\x01R\abalance\"\xaf\x01\n" +
+	"\fEnvelopeMove\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12#\n" +
+	"\rfrom_category\x18\x02 \x01(\tR\ffromCategory\x12\x1f\n" +
+	"\vto_category\x18\x03 \x01(\tR\n" +
+	"toCategory\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"\xc1\x01\n" +
+	"\x0fEnvelopeSummary\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x12\x16\n" +
+	"\x06income\x18\x02 \x01(\x01R\x06income\x12\x1e\n" +
+	"\n" +
+	"unassigned\x18\x03 \x01(\x01R\n" +
+	"unassigned\x121\n" +
+	"\tenvelopes\x18\x04 \x03(\v2\x13.pb_ledger.EnvelopeR\tenvelopes\x12-\n" +
+	"\x05moves\x18\x05 \x03(\v2\x17.pb_ledger.EnvelopeMoveR\x05moves\"t\n" +
+	"\x1aCreateExpenseReportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12'\n" +
+	"\x0ftransaction_ids\x18\x03 \x03(\x03R\x0etransactionIds\"j\n" +
+	"\x1aExpenseReportStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"w\n" +
+	"\x18ExpenseReportItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x12%\n" +
+	"\x0etransaction_id\x18\x03 \x01(\x03R\rtransactionId\"\x81\x01\n" +
+	"\x10ReimburseRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\treport_id\x18\x02 \x01(\x03R\breportId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\voccurred_at\x18\x04 \x01(\tR\n" +
+	"occurredAt\"L\n" +
+	"\x19ListExpenseReportsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xab\x01\n" +
+	"\vExpenseItem\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\"\xf2\x02\n" +
+	"\rExpenseReport\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\x03R\breportId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x01R\x05total\x12+\n" +
+	"\x11reimbursed_amount\x18\x05 \x01(\x01R\x10reimbursedAmount\x12)\n" +
+	"\x10reimbursement_id\x18\x06 \x01(\x03R\x0freimbursementId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12!\n" +
+	"\fsubmitted_at\x18\b \x01(\tR\vsubmittedAt\x12\x1f\n" +
+	"\vapproved_at\x18\t \x01(\tR\n" +
+	"approvedAt\x12\x17\n" +
+	"\apaid_at\x18\n" +
+	" \x01(\tR\x06paidAt\x12,\n" +
+	"\x05items\x18\v \x03(\v2\x16.pb_ledger.ExpenseItemR\x05items\"}\n" +
+	"\x15ExpenseReportResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\x06report\x18\x03 \x01(\v2\x18.pb_ledger.ExpenseReportR\x06report\"G\n" +
+	"\x11ExpenseReportList\x122\n" +
+	"\areports\x18\x01 \x03(\v2\x18.pb_ledger.ExpenseReportR\areports\"\xaa\x01\n" +
+	"\rRefundRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\"l\n" +
+	"\x17ListTransactionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xa8\x03\n" +
+	"\vLedgerEntry\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\x03R\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x1b\n" +
+	"\trefund_of\x18\b \x01(\x03R\brefundOf\x12\x1d\n" +
+	"\n" +
+	"applies_at\x18\t \x01(\tR\tappliesAt\x12'\n" +
+	"\x0frefunded_amount\x18\n" +
+	" \x01(\x01R\x0erefundedAmount\x12\"\n" +
+	"\freimbursable\x18\v \x01(\bR\freimbursable\x12\x1e\n" +
+	"\n" +
+	"reimbursed\x18\f \x01(\bR\n" +
+	"reimbursed\x12*\n" +
+	"\x11expense_report_id\x18\r \x01(\x03R\x0fexpenseReportId\"M\n" +
+	"\x0fTransactionList\x12:\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x16.pb_ledger.LedgerEntryR\ftransactions2\x8b\"\n" +
+	"\rLedgerService\x12R\n" +
+	"\x11CreateTransaction\x12\x1d.pb_ledger.TransactionRequest\x1a\x1e.pb_ledger.TransactionResponse\x12@\n" +
+	"\tGetReport\x12\x18.pb_ledger.ReportRequest\x1a\x19.pb_ledger.ReportResponse\x12@\n" +
+	"\tSetBudget\x12\x18.pb_ledger.BudgetRequest\x1a\x19.pb_ledger.BudgetResponse\x12A\n" +
+	"\n" +
+	"GetBudgets\x12\x1c.pb_ledger.GetBudgetsRequest\x1a\x15.pb_ledger.BudgetList\x12M\n" +
+	"\x10GetBudgetHistory\x12\x1f.pb_ledger.BudgetHistoryRequest\x1a\x18.pb_ledger.BudgetHistory\x12N\n" +
+	"\x10ListBudgetLimits\x12\x1e.pb_ledger.BudgetLimitsRequest\x1a\x1a.pb_ledger.BudgetLimitList\x12Y\n" +
+	"\x12SaveBudgetTemplate\x12 .pb_ledger.BudgetTemplateRequest\x1a!.pb_ledger.BudgetTemplateResponse\x12[\n" +
+	"\x13ListBudgetTemplates\x12%.pb_ledger.ListBudgetTemplatesRequest\x1a\x1d.pb_ledger.BudgetTemplateList\x12O\n" +
+	"\fApplyBudgets\x12\x1e.pb_ledger.ApplyBudgetsRequest\x1a\x1f.pb_ledger.ApplyBudgetsResponse\x12U\n" +
+	"\x10SetCategoryGroup\x12\x1f.pb_ledger.CategoryGroupRequest\x1a .pb_ledger.CategoryGroupResponse\x12X\n" +
+	"\x12ListCategoryGroups\x12$.pb_ledger.ListCategoryGroupsRequest\x1a\x1c.pb_ledger.CategoryGroupList\x12F\n" +
+	"\vGetForecast\x12\x1a.pb_ledger.ForecastRequest\x1a\x1b.pb_ledger.ForecastResponse\x12H\n" +
+	"\rListAnomalies\x12\x1f.pb_ledger.ListAnomaliesRequest\x1a\x16.pb_ledger.AnomalyList\x12R\n" +
+	"\rReviewAnomaly\x12\x1f.pb_ledger.ReviewAnomalyRequest\x1a .pb_ledger.ReviewAnomalyResponse\x12F\n" +
+	"\rCompareReport\x12\x19.pb_ledger.CompareRequest\x1a\x1a.pb_ledger.CompareResponse\x12A\n" +
+	"\x0eGetPivotReport\x12\x17.pb_ledger.PivotRequest\x1a\x16.pb_ledger.PivotReport\x12L\n" +
+	"\rGetStatistics\x12\x1c.pb_ledger.StatisticsRequest\x1a\x1d.pb_ledger.StatisticsResponse\x12E\n" +
+	"\vGetSettings\x12\x1d.pb_ledger.GetSettingsRequest\x1a\x17.pb_ledger.UserSettings\x12I\n" +
+	"\vSetTimezone\x12\x1d.pb_ledger.SetTimezoneRequest\x1a\x1b.pb_ledger.SettingsResponse\x12M\n" +
+	"\rSetBudgetMode\x12\x1f.pb_ledger.SetBudgetModeRequest\x1a\x1b.pb_ledger.SettingsResponse\x12\\\n" +
+	"\x12CreateTransactions\x12\x1f.pb_ledger.BatchTransactionItem\x1a#.pb_ledger.BatchTransactionResponse(\x01\x12@\n" +
+	"\vWatchLedger\x12\x17.pb_ledger.WatchRequest\x1a\x16.pb_ledger.LedgerEvent0\x01\x12X\n" +
+	"\x11UpdateTransaction\x12#.pb_ledger.UpdateTransactionRequest\x1a\x1e.pb_ledger.TransactionResponse\x12X\n" +
+	"\x11DeleteTransaction\x12#.pb_ledger.DeleteTransactionRequest\x1a\x1e.pb_ledger.TransactionResponse\x12C\n" +
+	"\bSyncPush\x12\x1a.pb_ledger.SyncPushRequest\x1a\x1b.pb_ledger.SyncPushResponse\x12C\n" +
+	"\n" +
+	"GetChanges\x12\x19.pb_ledger.ChangesRequest\x1a\x1a.pb_ledger.ChangesResponse\x12C\n" +
+	"\n" +
+	"CreateGoal\x12\x1c.pb_ledger.CreateGoalRequest\x1a\x17.pb_ledger.GoalResponse\x12L\n" +
+	"\n" +
+	"Contribute\x12\x1e.pb_ledger.ContributionRequest\x1a\x1e.pb_ledger.TransactionResponse\x12;\n" +
+	"\bGetGoals\x12\x1a.pb_ledger.GetGoalsRequest\x1a\x13.pb_ledger.GoalList\x12U\n" +
+	"\x10UploadAttachment\x12\".pb_ledger.UploadAttachmentRequest\x1a\x1d.pb_ledger.AttachmentResponse\x12K\n" +
+	"\rGetAttachment\x12\x1f.pb_ledger.GetAttachmentRequest\x1a\x19.pb_ledger.AttachmentData\x12O\n" +
+	"\x0fListAttachments\x12!.pb_ledger.ListAttachmentsRequest\x1a\x19.pb_ledger.AttachmentList\x12N\n" +
+	"\x11CreateFromReceipt\x12\x19.pb_ledger.ReceiptRequest\x1a\x1e.pb_ledger.TransactionResponse\x12C\n" +
+	"\bQuickAdd\x12\x1a.pb_ledger.QuickAddRequest\x1a\x1b.pb_ledger.QuickAddResponse\x12=\n" +
+	"\x06Search\x12\x18.pb_ledger.SearchRequest\x1a\x19.pb_ledger.SearchResponse\x12O\n" +
+	"\x0eCreateMerchant\x12 .pb_ledger.CreateMerchantRequest\x1a\x1b.pb_ledger.MerchantResponse\x12P\n" +
+	"\x10AddMerchantAlias\x12\x1f.pb_ledger.MerchantAliasRequest\x1a\x1b.pb_ledger.MerchantResponse\x12I\n" +
+	"\rListMerchants\x12\x1f.pb_ledger.ListMerchantsRequest\x1a\x17.pb_ledger.MerchantList\x12R\n" +
+	"\x0fGetTopMerchants\x12\x1e.pb_ledger.TopMerchantsRequest\x1a\x1f.pb_ledger.TopMerchantsResponse\x12U\n" +
+	"\x11ListSubscriptions\x12#.pb_ledger.ListSubscriptionsRequest\x1a\x1b.pb_ledger.SubscriptionList\x12C\n" +
 	"\n" +
-	"GetBudgets\x12\x1c.pb_ledger.GetBudgetsRequest\x1a\x15.pb_ledger.BudgetListB\x13Z\x11./proto/pb_ledgerb\x06proto3"
+	"CreateDebt\x12\x1c.pb_ledger.CreateDebtRequest\x1a\x17.pb_ledger.DebtResponse\x12R\n" +
+	"\x11RecordDebtPayment\x12\x1d.pb_ledger.DebtPaymentRequest\x1a\x1e.pb_ledger.TransactionResponse\x12=\n" +
+	"\tListDebts\x12\x1b.pb_ledger.ListDebtsRequest\x1a\x13.pb_ledger.DebtList\x12J\n" +
+	"\x0fGetDebtSchedule\x12\x1e.pb_ledger.DebtScheduleRequest\x1a\x17.pb_ledger.DebtSchedule\x12H\n" +
+	"\fRecordIncome\x12\x18.pb_ledger.IncomeRequest\x1a\x1e.pb_ledger.TransactionResponse\x12K\n" +
+	"\fMoveEnvelope\x12\x1e.pb_ledger.EnvelopeMoveRequest\x1a\x1b.pb_ledger.EnvelopeResponse\x12J\n" +
+	"\fGetEnvelopes\x12\x1e.pb_ledger.GetEnvelopesRequest\x1a\x1a.pb_ledger.EnvelopeSummary\x12^\n" +
+	"\x13CreateExpenseReport\x12%.pb_ledger.CreateExpenseReportRequest\x1a .pb_ledger.ExpenseReportResponse\x12a\n" +
+	"\x16SetExpenseReportStatus\x12%.pb_ledger.ExpenseReportStatusRequest\x1a .pb_ledger.ExpenseReportResponse\x12`\n" +
+	"\x17RemoveExpenseReportItem\x12#.pb_ledger.ExpenseReportItemRequest\x1a .pb_ledger.ExpenseReportResponse\x12U\n" +
+	"\x16ReimburseExpenseReport\x12\x1b.pb_ledger.ReimburseRequest\x1a\x1e.pb_ledger.TransactionResponse\x12X\n" +
+	"\x12ListExpenseReports\x12$.pb_ledger.ListExpenseReportsRequest\x1a\x1c.pb_ledger.ExpenseReportList\x12H\n" +
+	"\fRecordRefund\x12\x18.pb_ledger.RefundRequest\x1a\x1e.pb_ledger.TransactionResponse\x12[\n" +
+	"\x14SetRefundAttribution\x12&.pb_ledger.SetRefundAttributionRequest\x1a\x1b.pb_ledger.SettingsResponse\x12R\n" +
+	"\x10ListTransactions\x12\".pb_ledger.ListTransactionsRequest\x1a\x1a.pb_ledger.TransactionListB\x13Z\x11./proto/pb_ledgerb\x06proto3"
 
 var (
 	file_proto_ledger_proto_rawDescOnce sync.Once
@@ -547,35 +9347,294 @@ func file_proto_ledger_proto_rawDescGZIP() []byte {
 	return file_proto_ledger_proto_rawDescData
 }
 
-var file_proto_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 128)
 var file_proto_ledger_proto_goTypes = []any{
-	(*TransactionRequest)(nil),  // 0: pb_ledger.TransactionRequest
-	(*TransactionResponse)(nil), // 1: pb_ledger.TransactionResponse
-	(*ReportRequest)(nil),       // 2: pb_ledger.ReportRequest
-	(*ReportResponse)(nil),      // 3: pb_ledger.ReportResponse
-	(*BudgetRequest)(nil),       // 4: pb_ledger.BudgetRequest
-	(*BudgetResponse)(nil),      // 5: pb_ledger.BudgetResponse
-	(*GetBudgetsRequest)(nil),   // 6: pb_ledger.GetBudgetsRequest
-	(*Budget)(nil),              // 7: pb_ledger.Budget
-	(*BudgetList)(nil),          // 8: pb_ledger.BudgetList
-	nil,                         // 9: pb_ledger.ReportResponse.ByCategoryEntry
+	(*TransactionRequest)(nil),          // 0: pb_ledger.TransactionRequest
+	(*TransactionResponse)(nil),         // 1: pb_ledger.TransactionResponse
+	(*ReportRequest)(nil),               // 2: pb_ledger.ReportRequest
+	(*ReportResponse)(nil),              // 3: pb_ledger.ReportResponse
+	(*BudgetRequest)(nil),               // 4: pb_ledger.BudgetRequest
+	(*BudgetResponse)(nil),              // 5: pb_ledger.BudgetResponse
+	(*GetBudgetsRequest)(nil),           // 6: pb_ledger.GetBudgetsRequest
+	(*Budget)(nil),                      // 7: pb_ledger.Budget
+	(*BudgetList)(nil),                  // 8: pb_ledger.BudgetList
+	(*BudgetHistoryRequest)(nil),        // 9: pb_ledger.BudgetHistoryRequest
+	(*BudgetPeriod)(nil),                // 10: pb_ledger.BudgetPeriod
+	(*BudgetHistory)(nil),               // 11: pb_ledger.BudgetHistory
+	(*BudgetLimitsRequest)(nil),         // 12: pb_ledger.BudgetLimitsRequest
+	(*BudgetLimit)(nil),                 // 13: pb_ledger.BudgetLimit
+	(*BudgetLimitList)(nil),             // 14: pb_ledger.BudgetLimitList
+	(*BudgetTemplateItem)(nil),          // 15: pb_ledger.BudgetTemplateItem
+	(*BudgetTemplateRequest)(nil),       // 16: pb_ledger.BudgetTemplateRequest
+	(*BudgetTemplateResponse)(nil),      // 17: pb_ledger.BudgetTemplateResponse
+	(*ListBudgetTemplatesRequest)(nil),  // 18: pb_ledger.ListBudgetTemplatesRequest
+	(*BudgetTemplate)(nil),              // 19: pb_ledger.BudgetTemplate
+	(*BudgetTemplateList)(nil),          // 20: pb_ledger.BudgetTemplateList
+	(*ApplyBudgetsRequest)(nil),         // 21: pb_ledger.ApplyBudgetsRequest
+	(*BudgetChange)(nil),                // 22: pb_ledger.BudgetChange
+	(*ApplyBudgetsResponse)(nil),        // 23: pb_ledger.ApplyBudgetsResponse
+	(*CategoryGroupRequest)(nil),        // 24: pb_ledger.CategoryGroupRequest
+	(*CategoryGroupResponse)(nil),       // 25: pb_ledger.CategoryGroupResponse
+	(*ListCategoryGroupsRequest)(nil),   // 26: pb_ledger.ListCategoryGroupsRequest
+	(*CategoryGroup)(nil),               // 27: pb_ledger.CategoryGroup
+	(*CategoryGroupList)(nil),           // 28: pb_ledger.CategoryGroupList
+	(*ForecastRequest)(nil),             // 29: pb_ledger.ForecastRequest
+	(*CategoryForecast)(nil),            // 30: pb_ledger.CategoryForecast
+	(*ForecastResponse)(nil),            // 31: pb_ledger.ForecastResponse
+	(*ListAnomaliesRequest)(nil),        // 32: pb_ledger.ListAnomaliesRequest
+	(*Anomaly)(nil),                     // 33: pb_ledger.Anomaly
+	(*AnomalyList)(nil),                 // 34: pb_ledger.AnomalyList
+	(*ReviewAnomalyRequest)(nil),        // 35: pb_ledger.ReviewAnomalyRequest
+	(*ReviewAnomalyResponse)(nil),       // 36: pb_ledger.ReviewAnomalyResponse
+	(*CompareRequest)(nil),              // 37: pb_ledger.CompareRequest
+	(*CategoryComparison)(nil),          // 38: pb_ledger.CategoryComparison
+	(*CompareResponse)(nil),             // 39: pb_ledger.CompareResponse
+	(*PivotRequest)(nil),                // 40: pb_ledger.PivotRequest
+	(*PivotRow)(nil),                    // 41: pb_ledger.PivotRow
+	(*PivotReport)(nil),                 // 42: pb_ledger.PivotReport
+	(*StatisticsRequest)(nil),           // 43: pb_ledger.StatisticsRequest
+	(*CategoryStats)(nil),               // 44: pb_ledger.CategoryStats
+	(*WeekdayStats)(nil),                // 45: pb_ledger.WeekdayStats
+	(*StatisticsResponse)(nil),          // 46: pb_ledger.StatisticsResponse
+	(*GetSettingsRequest)(nil),          // 47: pb_ledger.GetSettingsRequest
+	(*UserSettings)(nil),                // 48: pb_ledger.UserSettings
+	(*SetTimezoneRequest)(nil),          // 49: pb_ledger.SetTimezoneRequest
+	(*SetBudgetModeRequest)(nil),        // 50: pb_ledger.SetBudgetModeRequest
+	(*SetRefundAttributionRequest)(nil), // 51: pb_ledger.SetRefundAttributionRequest
+	(*SettingsResponse)(nil),            // 52: pb_ledger.SettingsResponse
+	(*BatchTransactionItem)(nil),        // 53: pb_ledger.BatchTransactionItem
+	(*BatchRowResult)(nil),              // 54: pb_ledger.BatchRowResult
+	(*BatchTransactionResponse)(nil),    // 55: pb_ledger.BatchTransactionResponse
+	(*WatchRequest)(nil),                // 56: pb_ledger.WatchRequest
+	(*LedgerEvent)(nil),                 // 57: pb_ledger.LedgerEvent
+	(*UpdateTransactionRequest)(nil),    // 58: pb_ledger.UpdateTransactionRequest
+	(*DeleteTransactionRequest)(nil),    // 59: pb_ledger.DeleteTransactionRequest
+	(*SyncRow)(nil),                     // 60: pb_ledger.SyncRow
+	(*SyncPushRequest)(nil),             // 61: pb_ledger.SyncPushRequest
+	(*SyncRowResult)(nil),               // 62: pb_ledger.SyncRowResult
+	(*SyncPushResponse)(nil),            // 63: pb_ledger.SyncPushResponse
+	(*ChangesRequest)(nil),              // 64: pb_ledger.ChangesRequest
+	(*ChangesResponse)(nil),             // 65: pb_ledger.ChangesResponse
+	(*CreateGoalRequest)(nil),           // 66: pb_ledger.CreateGoalRequest
+	(*GoalResponse)(nil),                // 67: pb_ledger.GoalResponse
+	(*ContributionRequest)(nil),         // 68: pb_ledger.ContributionRequest
+	(*GetGoalsRequest)(nil),             // 69: pb_ledger.GetGoalsRequest
+	(*GoalProgress)(nil),                // 70: pb_ledger.GoalProgress
+	(*GoalList)(nil),                    // 71: pb_ledger.GoalList
+	(*Attachment)(nil),                  // 72: pb_ledger.Attachment
+	(*UploadAttachmentRequest)(nil),     // 73: pb_ledger.UploadAttachmentRequest
+	(*AttachmentResponse)(nil),          // 74: pb_ledger.AttachmentResponse
+	(*GetAttachmentRequest)(nil),        // 75: pb_ledger.GetAttachmentRequest
+	(*AttachmentData)(nil),              // 76: pb_ledger.AttachmentData
+	(*ListAttachmentsRequest)(nil),      // 77: pb_ledger.ListAttachmentsRequest
+	(*AttachmentList)(nil),              // 78: pb_ledger.AttachmentList
+	(*ReceiptRequest)(nil),              // 79: pb_ledger.ReceiptRequest
+	(*QuickAddRequest)(nil),             // 80: pb_ledger.QuickAddRequest
+	(*QuickAddResponse)(nil),            // 81: pb_ledger.QuickAddResponse
+	(*SearchRequest)(nil),               // 82: pb_ledger.SearchRequest
+	(*SearchHit)(nil),                   // 83: pb_ledger.SearchHit
+	(*SearchResponse)(nil),              // 84: pb_ledger.SearchResponse
+	(*Merchant)(nil),                    // 85: pb_ledger.Merchant
+	(*CreateMerchantRequest)(nil),       // 86: pb_ledger.CreateMerchantRequest
+	(*MerchantAliasRequest)(nil),        // 87: pb_ledger.MerchantAliasRequest
+	(*MerchantResponse)(nil),            // 88: pb_ledger.MerchantResponse
+	(*ListMerchantsRequest)(nil),        // 89: pb_ledger.ListMerchantsRequest
+	(*MerchantList)(nil),                // 90: pb_ledger.MerchantList
+	(*TopMerchantsRequest)(nil),         // 91: pb_ledger.TopMerchantsRequest
+	(*MerchantStats)(nil),               // 92: pb_ledger.MerchantStats
+	(*TopMerchantsResponse)(nil),        // 93: pb_ledger.TopMerchantsResponse
+	(*ListSubscriptionsRequest)(nil),    // 94: pb_ledger.ListSubscriptionsRequest
+	(*Subscription)(nil),                // 95: pb_ledger.Subscription
+	(*SubscriptionList)(nil),            // 96: pb_ledger.SubscriptionList
+	(*CreateDebtRequest)(nil),           // 97: pb_ledger.CreateDebtRequest
+	(*DebtResponse)(nil),                // 98: pb_ledger.DebtResponse
+	(*DebtPaymentRequest)(nil),          // 99: pb_ledger.DebtPaymentRequest
+	(*ListDebtsRequest)(nil),            // 100: pb_ledger.ListDebtsRequest
+	(*Debt)(nil),                        // 101: pb_ledger.Debt
+	(*DebtList)(nil),                    // 102: pb_ledger.DebtList
+	(*DebtScheduleRequest)(nil),         // 103: pb_ledger.DebtScheduleRequest
+	(*AmortisationRow)(nil),             // 104: pb_ledger.AmortisationRow
+	(*PayoffProjection)(nil),            // 105: pb_ledger.PayoffProjection
+	(*DebtSchedule)(nil),                // 106: pb_ledger.DebtSchedule
+	(*IncomeRequest)(nil),               // 107: pb_ledger.IncomeRequest
+	(*EnvelopeMoveRequest)(nil),         // 108: pb_ledger.EnvelopeMoveRequest
+	(*EnvelopeResponse)(nil),            // 109: pb_ledger.EnvelopeResponse
+	(*GetEnvelopesRequest)(nil),         // 110: pb_ledger.GetEnvelopesRequest
+	(*Envelope)(nil),                    // 111: pb_ledger.Envelope
+	(*EnvelopeMove)(nil),                // 112: pb_ledger.EnvelopeMove
+	(*EnvelopeSummary)(nil),             // 113: pb_ledger.EnvelopeSummary
+	(*CreateExpenseReportRequest)(nil),  // 114: pb_ledger.CreateExpenseReportRequest
+	(*ExpenseReportStatusRequest)(nil),  // 115: pb_ledger.ExpenseReportStatusRequest
+	(*ExpenseReportItemRequest)(nil),    // 116: pb_ledger.ExpenseReportItemRequest
+	(*ReimburseRequest)(nil),            // 117: pb_ledger.ReimburseRequest
+	(*ListExpenseReportsRequest)(nil),   // 118: pb_ledger.ListExpenseReportsRequest
+	(*ExpenseItem)(nil),                 // 119: pb_ledger.ExpenseItem
+	(*ExpenseReport)(nil),               // 120: pb_ledger.ExpenseReport
+	(*ExpenseReportResponse)(nil),       // 121: pb_ledger.ExpenseReportResponse
+	(*ExpenseReportList)(nil),           // 122: pb_ledger.ExpenseReportList
+	(*RefundRequest)(nil),               // 123: pb_ledger.RefundRequest
+	(*ListTransactionsRequest)(nil),     // 124: pb_ledger.ListTransactionsRequest
+	(*LedgerEntry)(nil),                 // 125: pb_ledger.LedgerEntry
+	(*TransactionList)(nil),             // 126: pb_ledger.TransactionList
+	nil,                                 // 127: pb_ledger.ReportResponse.ByCategoryEntry
 }
 var file_proto_ledger_proto_depIdxs = []int32{
-	9, // 0: pb_ledger.ReportResponse.by_category:type_name -> pb_ledger.ReportResponse.ByCategoryEntry
-	7, // 1: pb_ledger.BudgetList.budgets:type_name -> pb_ledger.Budget
-	0, // 2: pb_ledger.LedgerService.CreateTransaction:input_type -> pb_ledger.TransactionRequest
-	2, // 3: pb_ledger.LedgerService.GetReport:input_type -> pb_ledger.ReportRequest
-	4, // 4: pb_ledger.LedgerService.SetBudget:input_type -> pb_ledger.BudgetRequest
-	6, // 5: pb_ledger.LedgerService.GetBudgets:input_type -> pb_ledger.GetBudgetsRequest
-	1, // 6: pb_ledger.LedgerService.CreateTransaction:output_type -> pb_ledger.TransactionResponse
-	3, // 7: pb_ledger.LedgerService.GetReport:output_type -> pb_ledger.ReportResponse
-	5, // 8: pb_ledger.LedgerService.SetBudget:output_type -> pb_ledger.BudgetResponse
-	8, // 9: pb_ledger.LedgerService.GetBudgets:output_type -> pb_ledger.BudgetList
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	127, // 0: pb_ledger.ReportResponse.by_category:type_name -> pb_ledger.ReportResponse.ByCategoryEntry
+	7,   // 1: pb_ledger.BudgetList.budgets:type_name -> pb_ledger.Budget
+	10,  // 2: pb_ledger.BudgetHistory.periods:type_name -> pb_ledger.BudgetPeriod
+	13,  // 3: pb_ledger.BudgetLimitList.limits:type_name -> pb_ledger.BudgetLimit
+	15,  // 4: pb_ledger.BudgetTemplateRequest.items:type_name -> pb_ledger.BudgetTemplateItem
+	15,  // 5: pb_ledger.BudgetTemplate.items:type_name -> pb_ledger.BudgetTemplateItem
+	19,  // 6: pb_ledger.BudgetTemplateList.templates:type_name -> pb_ledger.BudgetTemplate
+	22,  // 7: pb_ledger.ApplyBudgetsResponse.changes:type_name -> pb_ledger.BudgetChange
+	27,  // 8: pb_ledger.CategoryGroupList.groups:type_name -> pb_ledger.CategoryGroup
+	30,  // 9: pb_ledger.ForecastResponse.categories:type_name -> pb_ledger.CategoryForecast
+	33,  // 10: pb_ledger.AnomalyList.anomalies:type_name -> pb_ledger.Anomaly
+	38,  // 11: pb_ledger.CompareResponse.categories:type_name -> pb_ledger.CategoryComparison
+	38,  // 12: pb_ledger.CompareResponse.top_movers:type_name -> pb_ledger.CategoryComparison
+	38,  // 13: pb_ledger.CompareResponse.newcomers:type_name -> pb_ledger.CategoryComparison
+	41,  // 14: pb_ledger.PivotReport.rows:type_name -> pb_ledger.PivotRow
+	44,  // 15: pb_ledger.StatisticsResponse.categories:type_name -> pb_ledger.CategoryStats
+	45,  // 16: pb_ledger.StatisticsResponse.weekdays:type_name -> pb_ledger.WeekdayStats
+	0,   // 17: pb_ledger.BatchTransactionItem.transaction:type_name -> pb_ledger.TransactionRequest
+	54,  // 18: pb_ledger.BatchTransactionResponse.results:type_name -> pb_ledger.BatchRowResult
+	60,  // 19: pb_ledger.SyncPushRequest.rows:type_name -> pb_ledger.SyncRow
+	60,  // 20: pb_ledger.SyncRowResult.server:type_name -> pb_ledger.SyncRow
+	62,  // 21: pb_ledger.SyncPushResponse.results:type_name -> pb_ledger.SyncRowResult
+	60,  // 22: pb_ledger.ChangesResponse.changes:type_name -> pb_ledger.SyncRow
+	70,  // 23: pb_ledger.GoalList.goals:type_name -> pb_ledger.GoalProgress
+	72,  // 24: pb_ledger.AttachmentResponse.attachment:type_name -> pb_ledger.Attachment
+	72,  // 25: pb_ledger.AttachmentData.attachment:type_name -> pb_ledger.Attachment
+	72,  // 26: pb_ledger.AttachmentList.attachments:type_name -> pb_ledger.Attachment
+	83,  // 27: pb_ledger.SearchResponse.hits:type_name -> pb_ledger.SearchHit
+	85,  // 28: pb_ledger.MerchantList.merchants:type_name -> pb_ledger.Merchant
+	92,  // 29: pb_ledger.TopMerchantsResponse.merchants:type_name -> pb_ledger.MerchantStats
+	95,  // 30: pb_ledger.SubscriptionList.subscriptions:type_name -> pb_ledger.Subscription
+	101, // 31: pb_ledger.DebtList.debts:type_name -> pb_ledger.Debt
+	101, // 32: pb_ledger.DebtSchedule.debt:type_name -> pb_ledger.Debt
+	104, // 33: pb_ledger.DebtSchedule.schedule:type_name -> pb_ledger.AmortisationRow
+	105, // 34: pb_ledger.DebtSchedule.projections:type_name -> pb_ledger.PayoffProjection
+	111, // 35: pb_ledger.EnvelopeSummary.envelopes:type_name -> pb_ledger.Envelope
+	112, // 36: pb_ledger.EnvelopeSummary.moves:type_name -> pb_ledger.EnvelopeMove
+	119, // 37: pb_ledger.ExpenseReport.items:type_name -> pb_ledger.ExpenseItem
+	120, // 38: pb_ledger.ExpenseReportResponse.report:type_name -> pb_ledger.ExpenseReport
+	120, // 39: pb_ledger.ExpenseReportList.reports:type_name -> pb_ledger.ExpenseReport
+	125, // 40: pb_ledger.TransactionList.transactions:type_name -> pb_ledger.LedgerEntry
+	0,   // 41: pb_ledger.LedgerService.CreateTransaction:input_type -> pb_ledger.TransactionRequest
+	2,   // 42: pb_ledger.LedgerService.GetReport:input_type -> pb_ledger.ReportRequest
+	4,   // 43: pb_ledger.LedgerService.SetBudget:input_type -> pb_ledger.BudgetRequest
+	6,   // 44: pb_ledger.LedgerService.GetBudgets:input_type -> pb_ledger.GetBudgetsRequest
+	9,   // 45: pb_ledger.LedgerService.GetBudgetHistory:input_type -> pb_ledger.BudgetHistoryRequest
+	12,  // 46: pb_ledger.LedgerService.ListBudgetLimits:input_type -> pb_ledger.BudgetLimitsRequest
+	16,  // 47: pb_ledger.LedgerService.SaveBudgetTemplate:input_type -> pb_ledger.BudgetTemplateRequest
+	18,  // 48: pb_ledger.LedgerService.ListBudgetTemplates:input_type -> pb_ledger.ListBudgetTemplatesRequest
+	21,  // 49: pb_ledger.LedgerService.ApplyBudgets:input_type -> pb_ledger.ApplyBudgetsRequest
+	24,  // 50: pb_ledger.LedgerService.SetCategoryGroup:input_type -> pb_ledger.CategoryGroupRequest
+	26,  // 51: pb_ledger.LedgerService.ListCategoryGroups:input_type -> pb_ledger.ListCategoryGroupsRequest
+	29,  // 52: pb_ledger.LedgerService.GetForecast:input_type -> pb_ledger.ForecastRequest
+	32,  // 53: pb_ledger.LedgerService.ListAnomalies:input_type -> pb_ledger.ListAnomaliesRequest
+	35,  // 54: pb_ledger.LedgerService.ReviewAnomaly:input_type -> pb_ledger.ReviewAnomalyRequest
+	37,  // 55: pb_ledger.LedgerService.CompareReport:input_type -> pb_ledger.CompareRequest
+	40,  // 56: pb_ledger.LedgerService.GetPivotReport:input_type -> pb_ledger.PivotRequest
+	43,  // 57: pb_ledger.LedgerService.GetStatistics:input_type -> pb_ledger.StatisticsRequest
+	47,  // 58: pb_ledger.LedgerService.GetSettings:input_type -> pb_ledger.GetSettingsRequest
+	49,  // 59: pb_ledger.LedgerService.SetTimezone:input_type -> pb_ledger.SetTimezoneRequest
+	50,  // 60: pb_ledger.LedgerService.SetBudgetMode:input_type -> pb_ledger.SetBudgetModeRequest
+	53,  // 61: pb_ledger.LedgerService.CreateTransactions:input_type -> pb_ledger.BatchTransactionItem
+	56,  // 62: pb_ledger.LedgerService.WatchLedger:input_type -> pb_ledger.WatchRequest
+	58,  // 63: pb_ledger.LedgerService.UpdateTransaction:input_type -> pb_ledger.UpdateTransactionRequest
+	59,  // 64: pb_ledger.LedgerService.DeleteTransaction:input_type -> pb_ledger.DeleteTransactionRequest
+	61,  // 65: pb_ledger.LedgerService.SyncPush:input_type -> pb_ledger.SyncPushRequest
+	64,  // 66: pb_ledger.LedgerService.GetChanges:input_type -> pb_ledger.ChangesRequest
+	66,  // 67: pb_ledger.LedgerService.CreateGoal:input_type -> pb_ledger.CreateGoalRequest
+	68,  // 68: pb_ledger.LedgerService.Contribute:input_type -> pb_ledger.ContributionRequest
+	69,  // 69: pb_ledger.LedgerService.GetGoals:input_type -> pb_ledger.GetGoalsRequest
+	73,  // 70: pb_ledger.LedgerService.UploadAttachment:input_type -> pb_ledger.UploadAttachmentRequest
+	75,  // 71: pb_ledger.LedgerService.GetAttachment:input_type -> pb_ledger.GetAttachmentRequest
+	77,  // 72: pb_ledger.LedgerService.ListAttachments:input_type -> pb_ledger.ListAttachmentsRequest
+	79,  // 73: pb_ledger.LedgerService.CreateFromReceipt:input_type -> pb_ledger.ReceiptRequest
+	80,  // 74: pb_ledger.LedgerService.QuickAdd:input_type -> pb_ledger.QuickAddRequest
+	82,  // 75: pb_ledger.LedgerService.Search:input_type -> pb_ledger.SearchRequest
+	86,  // 76: pb_ledger.LedgerService.CreateMerchant:input_type -> pb_ledger.CreateMerchantRequest
+	87,  // 77: pb_ledger.LedgerService.AddMerchantAlias:input_type -> pb_ledger.MerchantAliasRequest
+	89,  // 78: pb_ledger.LedgerService.ListMerchants:input_type -> pb_ledger.ListMerchantsRequest
+	91,  // 79: pb_ledger.LedgerService.GetTopMerchants:input_type -> pb_ledger.TopMerchantsRequest
+	94,  // 80: pb_ledger.LedgerService.ListSubscriptions:input_type -> pb_ledger.ListSubscriptionsRequest
+	97,  // 81: pb_ledger.LedgerService.CreateDebt:input_type -> pb_ledger.CreateDebtRequest
+	99,  // 82: pb_ledger.LedgerService.RecordDebtPayment:input_type -> pb_ledger.DebtPaymentRequest
+	100, // 83: pb_ledger.LedgerService.ListDebts:input_type -> pb_ledger.ListDebtsRequest
+	103, // 84: pb_ledger.LedgerService.GetDebtSchedule:input_type -> pb_ledger.DebtScheduleRequest
+	107, // 85: pb_ledger.LedgerService.RecordIncome:input_type -> pb_ledger.IncomeRequest
+	108, // 86: pb_ledger.LedgerService.MoveEnvelope:input_type -> pb_ledger.EnvelopeMoveRequest
+	110, // 87: pb_ledger.LedgerService.GetEnvelopes:input_type -> pb_ledger.GetEnvelopesRequest
+	114, // 88: pb_ledger.LedgerService.CreateExpenseReport:input_type -> pb_ledger.CreateExpenseReportRequest
+	115, // 89: pb_ledger.LedgerService.SetExpenseReportStatus:input_type -> pb_ledger.ExpenseReportStatusRequest
+	116, // 90: pb_ledger.LedgerService.RemoveExpenseReportItem:input_type -> pb_ledger.ExpenseReportItemRequest
+	117, // 91: pb_ledger.LedgerService.ReimburseExpenseReport:input_type -> pb_ledger.ReimburseRequest
+	118, // 92: pb_ledger.LedgerService.ListExpenseReports:input_type -> pb_ledger.ListExpenseReportsRequest
+	123, // 93: pb_ledger.LedgerService.RecordRefund:input_type -> pb_ledger.RefundRequest
+	51,  // 94: pb_ledger.LedgerService.SetRefundAttribution:input_type -> pb_ledger.SetRefundAttributionRequest
+	124, // 95: pb_ledger.LedgerService.ListTransactions:input_type -> pb_ledger.ListTransactionsRequest
+	1,   // 96: pb_ledger.LedgerService.CreateTransaction:output_type -> pb_ledger.TransactionResponse
+	3,   // 97: pb_ledger.LedgerService.GetReport:output_type -> pb_ledger.ReportResponse
+	5,   // 98: pb_ledger.LedgerService.SetBudget:output_type -> pb_ledger.BudgetResponse
+	8,   // 99: pb_ledger.LedgerService.GetBudgets:output_type -> pb_ledger.BudgetList
+	11,  // 100: pb_ledger.LedgerService.GetBudgetHistory:output_type -> pb_ledger.BudgetHistory
+	14,  // 101: pb_ledger.LedgerService.ListBudgetLimits:output_type -> pb_ledger.BudgetLimitList
+	17,  // 102: pb_ledger.LedgerService.SaveBudgetTemplate:output_type -> pb_ledger.BudgetTemplateResponse
+	20,  // 103: pb_ledger.LedgerService.ListBudgetTemplates:output_type -> pb_ledger.BudgetTemplateList
+	23,  // 104: pb_ledger.LedgerService.ApplyBudgets:output_type -> pb_ledger.ApplyBudgetsResponse
+	25,  // 105: pb_ledger.LedgerService.SetCategoryGroup:output_type -> pb_ledger.CategoryGroupResponse
+	28,  // 106: pb_ledger.LedgerService.ListCategoryGroups:output_type -> pb_ledger.CategoryGroupList
+	31,  // 107: pb_ledger.LedgerService.GetForecast:output_type -> pb_ledger.ForecastResponse
+	34,  // 108: pb_ledger.LedgerService.ListAnomalies:output_type -> pb_ledger.AnomalyList
+	36,  // 109: pb_ledger.LedgerService.ReviewAnomaly:output_type -> pb_ledger.ReviewAnomalyResponse
+	39,  // 110: pb_ledger.LedgerService.CompareReport:output_type -> pb_ledger.CompareResponse
+	42,  // 111: pb_ledger.LedgerService.GetPivotReport:output_type -> pb_ledger.PivotReport
+	46,  // 112: pb_ledger.LedgerService.GetStatistics:output_type -> pb_ledger.StatisticsResponse
+	48,  // 113: pb_ledger.LedgerService.GetSettings:output_type -> pb_ledger.UserSettings
+	52,  // 114: pb_ledger.LedgerService.SetTimezone:output_type -> pb_ledger.SettingsResponse
+	52,  // 115: pb_ledger.LedgerService.SetBudgetMode:output_type -> pb_ledger.SettingsResponse
+	55,  // 116: pb_ledger.LedgerService.CreateTransactions:output_type -> pb_ledger.BatchTransactionResponse
+	57,  // 117: pb_ledger.LedgerService.WatchLedger:output_type -> pb_ledger.LedgerEvent
+	1,   // 118: pb_ledger.LedgerService.UpdateTransaction:output_type -> pb_ledger.TransactionResponse
+	1,   // 119: pb_ledger.LedgerService.DeleteTransaction:output_type -> pb_ledger.TransactionResponse
+	63,  // 120: pb_ledger.LedgerService.SyncPush:output_type -> pb_ledger.SyncPushResponse
+	65,  // 121: pb_ledger.LedgerService.GetChanges:output_type -> pb_ledger.ChangesResponse
+	67,  // 122: pb_ledger.LedgerService.CreateGoal:output_type -> pb_ledger.GoalResponse
+	1,   // 123: pb_ledger.LedgerService.Contribute:output_type -> pb_ledger.TransactionResponse
+	71,  // 124: pb_ledger.LedgerService.GetGoals:output_type -> pb_ledger.GoalList
+	74,  // 125: pb_ledger.LedgerService.UploadAttachment:output_type -> pb_ledger.AttachmentResponse
+	76,  // 126: pb_ledger.LedgerService.GetAttachment:output_type -> pb_ledger.AttachmentData
+	78,  // 127: pb_ledger.LedgerService.ListAttachments:output_type -> pb_ledger.AttachmentList
+	1,   // 128: pb_ledger.LedgerService.CreateFromReceipt:output_type -> pb_ledger.TransactionResponse
+	81,  // 129: pb_ledger.LedgerService.QuickAdd:output_type -> pb_ledger.QuickAddResponse
+	84,  // 130: pb_ledger.LedgerService.Search:output_type -> pb_ledger.SearchResponse
+	88,  // 131: pb_ledger.LedgerService.CreateMerchant:output_type -> pb_ledger.MerchantResponse
+	88,  // 132: pb_ledger.LedgerService.AddMerchantAlias:output_type -> pb_ledger.MerchantResponse
+	90,  // 133: pb_ledger.LedgerService.ListMerchants:output_type -> pb_ledger.MerchantList
+	93,  // 134: pb_ledger.LedgerService.GetTopMerchants:output_type -> pb_ledger.TopMerchantsResponse
+	96,  // 135: pb_ledger.LedgerService.ListSubscriptions:output_type -> pb_ledger.SubscriptionList
+	98,  // 136: pb_ledger.LedgerService.CreateDebt:output_type -> pb_ledger.DebtResponse
+	1,   // 137: pb_ledger.LedgerService.RecordDebtPayment:output_type -> pb_ledger.TransactionResponse
+	102, // 138: pb_ledger.LedgerService.ListDebts:output_type -> pb_ledger.DebtList
+	106, // 139: pb_ledger.LedgerService.GetDebtSchedule:output_type -> pb_ledger.DebtSchedule
+	1,   // 140: pb_ledger.LedgerService.RecordIncome:output_type -> pb_ledger.TransactionResponse
+	109, // 141: pb_ledger.LedgerService.MoveEnvelope:output_type -> pb_ledger.EnvelopeResponse
+	113, // 142: pb_ledger.LedgerService.GetEnvelopes:output_type -> pb_ledger.EnvelopeSummary
+	121, // 143: pb_ledger.LedgerService.CreateExpenseReport:output_type -> pb_ledger.ExpenseReportResponse
+	121, // 144: pb_ledger.LedgerService.SetExpenseReportStatus:output_type -> pb_ledger.ExpenseReportResponse
+	121, // 145: pb_ledger.LedgerService.RemoveExpenseReportItem:output_type -> pb_ledger.ExpenseReportResponse
+	1,   // 146: pb_ledger.LedgerService.ReimburseExpenseReport:output_type -> pb_ledger.TransactionResponse
+	122, // 147: pb_ledger.LedgerService.ListExpenseReports:output_type -> pb_ledger.ExpenseReportList
+	1,   // 148: pb_ledger.LedgerService.RecordRefund:output_type -> pb_ledger.TransactionResponse
+	52,  // 149: pb_ledger.LedgerService.SetRefundAttribution:output_type -> pb_ledger.SettingsResponse
+	126, // 150: pb_ledger.LedgerService.ListTransactions:output_type -> pb_ledger.TransactionList
+	96,  // [96:151] is the sub-list for method output_type
+	41,  // [41:96] is the sub-list for method input_type
+	41,  // [41:41] is the sub-list for extension type_name
+	41,  // [41:41] is the sub-list for extension extendee
+	0,   // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_proto_ledger_proto_init() }
@@ -589,7 +9648,7 @@ func file_proto_ledger_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ledger_proto_rawDesc), len(file_proto_ledger_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   128,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
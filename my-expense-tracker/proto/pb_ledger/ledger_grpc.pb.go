@@ -19,10 +19,61 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LedgerService_CreateTransaction_FullMethodName = "/pb_ledger.LedgerService/CreateTransaction"
-	LedgerService_GetReport_FullMethodName         = "/pb_ledger.LedgerService/GetReport"
-	LedgerService_SetBudget_FullMethodName         = "/pb_ledger.LedgerService/SetBudget"
-	LedgerService_GetBudgets_FullMethodName        = "/pb_ledger.LedgerService/GetBudgets"
+	LedgerService_CreateTransaction_FullMethodName       = "/pb_ledger.LedgerService/CreateTransaction"
+	LedgerService_GetReport_FullMethodName               = "/pb_ledger.LedgerService/GetReport"
+	LedgerService_SetBudget_FullMethodName               = "/pb_ledger.LedgerService/SetBudget"
+	LedgerService_GetBudgets_FullMethodName              = "/pb_ledger.LedgerService/GetBudgets"
+	LedgerService_GetBudgetHistory_FullMethodName        = "/pb_ledger.LedgerService/GetBudgetHistory"
+	LedgerService_ListBudgetLimits_FullMethodName        = "/pb_ledger.LedgerService/ListBudgetLimits"
+	LedgerService_SaveBudgetTemplate_FullMethodName      = "/pb_ledger.LedgerService/SaveBudgetTemplate"
+	LedgerService_ListBudgetTemplates_FullMethodName     = "/pb_ledger.LedgerService/ListBudgetTemplates"
+	LedgerService_ApplyBudgets_FullMethodName            = "/pb_ledger.LedgerService/ApplyBudgets"
+	LedgerService_SetCategoryGroup_FullMethodName        = "/pb_ledger.LedgerService/SetCategoryGroup"
+	LedgerService_ListCategoryGroups_FullMethodName      = "/pb_ledger.LedgerService/ListCategoryGroups"
+	LedgerService_GetForecast_FullMethodName             = "/pb_ledger.LedgerService/GetForecast"
+	LedgerService_ListAnomalies_FullMethodName           = "/pb_ledger.LedgerService/ListAnomalies"
+	LedgerService_ReviewAnomaly_FullMethodName           = "/pb_ledger.LedgerService/ReviewAnomaly"
+	LedgerService_CompareReport_FullMethodName           = "/pb_ledger.LedgerService/CompareReport"
+	LedgerService_GetPivotReport_FullMethodName          = "/pb_ledger.LedgerService/GetPivotReport"
+	LedgerService_GetStatistics_FullMethodName           = "/pb_ledger.LedgerService/GetStatistics"
+	LedgerService_GetSettings_FullMethodName             = "/pb_ledger.LedgerService/GetSettings"
+	LedgerService_SetTimezone_FullMethodName             = "/pb_ledger.LedgerService/SetTimezone"
+	LedgerService_SetBudgetMode_FullMethodName           = "/pb_ledger.LedgerService/SetBudgetMode"
+	LedgerService_CreateTransactions_FullMethodName      = "/pb_ledger.LedgerService/CreateTransactions"
+	LedgerService_WatchLedger_FullMethodName             = "/pb_ledger.LedgerService/WatchLedger"
+	LedgerService_UpdateTransaction_FullMethodName       = "/pb_ledger.LedgerService/UpdateTransaction"
+	LedgerService_DeleteTransaction_FullMethodName       = "/pb_ledger.LedgerService/DeleteTransaction"
+	LedgerService_SyncPush_FullMethodName                = "/pb_ledger.LedgerService/SyncPush"
+	LedgerService_GetChanges_FullMethodName              = "/pb_ledger.LedgerService/GetChanges"
+	LedgerService_CreateGoal_FullMethodName              = "/pb_ledger.LedgerService/CreateGoal"
+	LedgerService_Contribute_FullMethodName              = "/pb_ledger.LedgerService/Contribute"
+	LedgerService_GetGoals_FullMethodName                = "/pb_ledger.LedgerService/GetGoals"
+	LedgerService_UploadAttachment_FullMethodName        = "/pb_ledger.LedgerService/UploadAttachment"
+	LedgerService_GetAttachment_FullMethodName           = "/pb_ledger.LedgerService/GetAttachment"
+	LedgerService_ListAttachments_FullMethodName         = "/pb_ledger.LedgerService/ListAttachments"
+	LedgerService_CreateFromReceipt_FullMethodName       = "/pb_ledger.LedgerService/CreateFromReceipt"
+	LedgerService_QuickAdd_FullMethodName                = "/pb_ledger.LedgerService/QuickAdd"
+	LedgerService_Search_FullMethodName                  = "/pb_ledger.LedgerService/Search"
+	LedgerService_CreateMerchant_FullMethodName          = "/pb_ledger.LedgerService/CreateMerchant"
+	LedgerService_AddMerchantAlias_FullMethodName        = "/pb_ledger.LedgerService/AddMerchantAlias"
+	LedgerService_ListMerchants_FullMethodName           = "/pb_ledger.LedgerService/ListMerchants"
+	LedgerService_GetTopMerchants_FullMethodName         = "/pb_ledger.LedgerService/GetTopMerchants"
+	LedgerService_ListSubscriptions_FullMethodName       = "/pb_ledger.LedgerService/ListSubscriptions"
+	LedgerService_CreateDebt_FullMethodName              = "/pb_ledger.LedgerService/CreateDebt"
+	LedgerService_RecordDebtPayment_FullMethodName       = "/pb_ledger.LedgerService/RecordDebtPayment"
+	LedgerService_ListDebts_FullMethodName               = "/pb_ledger.LedgerService/ListDebts"
+	LedgerService_GetDebtSchedule_FullMethodName         = "/pb_ledger.LedgerService/GetDebtSchedule"
+	LedgerService_RecordIncome_FullMethodName            = "/pb_ledger.LedgerService/RecordIncome"
+	LedgerService_MoveEnvelope_FullMethodName            = "/pb_ledger.LedgerService/MoveEnvelope"
+	LedgerService_GetEnvelopes_FullMethodName            = "/pb_ledger.LedgerService/GetEnvelopes"
+	LedgerService_CreateExpenseReport_FullMethodName     = "/pb_ledger.LedgerService/CreateExpenseReport"
+	LedgerService_SetExpenseReportStatus_FullMethodName  = "/pb_ledger.LedgerService/SetExpenseReportStatus"
+	LedgerService_RemoveExpenseReportItem_FullMethodName = "/pb_ledger.LedgerService/RemoveExpenseReportItem"
+	LedgerService_ReimburseExpenseReport_FullMethodName  = "/pb_ledger.LedgerService/ReimburseExpenseReport"
+	LedgerService_ListExpenseReports_FullMethodName      = "/pb_ledger.LedgerService/ListExpenseReports"
+	LedgerService_RecordRefund_FullMethodName            = "/pb_ledger.LedgerService/RecordRefund"
+	LedgerService_SetRefundAttribution_FullMethodName    = "/pb_ledger.LedgerService/SetRefundAttribution"
+	LedgerService_ListTransactions_FullMethodName        = "/pb_ledger.LedgerService/ListTransactions"
 )
 
 // LedgerServiceClient is the client API for LedgerService service.
@@ -31,9 +82,59 @@ const (
 type LedgerServiceClient interface {
 	CreateTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
 	GetReport(ctx context.Context, in *ReportRequest, opts ...grpc.CallOption) (*ReportResponse, error)
-	// === НОВЫЕ МЕТОДЫ ДЛЯ БЮДЖЕТОВ ===
 	SetBudget(ctx context.Context, in *BudgetRequest, opts ...grpc.CallOption) (*BudgetResponse, error)
 	GetBudgets(ctx context.Context, in *GetBudgetsRequest, opts ...grpc.CallOption) (*BudgetList, error)
+	GetBudgetHistory(ctx context.Context, in *BudgetHistoryRequest, opts ...grpc.CallOption) (*BudgetHistory, error)
+	ListBudgetLimits(ctx context.Context, in *BudgetLimitsRequest, opts ...grpc.CallOption) (*BudgetLimitList, error)
+	SaveBudgetTemplate(ctx context.Context, in *BudgetTemplateRequest, opts ...grpc.CallOption) (*BudgetTemplateResponse, error)
+	ListBudgetTemplates(ctx context.Context, in *ListBudgetTemplatesRequest, opts ...grpc.CallOption) (*BudgetTemplateList, error)
+	ApplyBudgets(ctx context.Context, in *ApplyBudgetsRequest, opts ...grpc.CallOption) (*ApplyBudgetsResponse, error)
+	SetCategoryGroup(ctx context.Context, in *CategoryGroupRequest, opts ...grpc.CallOption) (*CategoryGroupResponse, error)
+	ListCategoryGroups(ctx context.Context, in *ListCategoryGroupsRequest, opts ...grpc.CallOption) (*CategoryGroupList, error)
+	GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*ForecastResponse, error)
+	ListAnomalies(ctx context.Context, in *ListAnomaliesRequest, opts ...grpc.CallOption) (*AnomalyList, error)
+	ReviewAnomaly(ctx context.Context, in *ReviewAnomalyRequest, opts ...grpc.CallOption) (*ReviewAnomalyResponse, error)
+	CompareReport(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error)
+	GetPivotReport(ctx context.Context, in *PivotRequest, opts ...grpc.CallOption) (*PivotReport, error)
+	GetStatistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error)
+	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*UserSettings, error)
+	SetTimezone(ctx context.Context, in *SetTimezoneRequest, opts ...grpc.CallOption) (*SettingsResponse, error)
+	SetBudgetMode(ctx context.Context, in *SetBudgetModeRequest, opts ...grpc.CallOption) (*SettingsResponse, error)
+	CreateTransactions(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BatchTransactionItem, BatchTransactionResponse], error)
+	WatchLedger(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerEvent], error)
+	UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	SyncPush(ctx context.Context, in *SyncPushRequest, opts ...grpc.CallOption) (*SyncPushResponse, error)
+	GetChanges(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (*ChangesResponse, error)
+	CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*GoalResponse, error)
+	Contribute(ctx context.Context, in *ContributionRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	GetGoals(ctx context.Context, in *GetGoalsRequest, opts ...grpc.CallOption) (*GoalList, error)
+	UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error)
+	GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*AttachmentData, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*AttachmentList, error)
+	CreateFromReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	QuickAdd(ctx context.Context, in *QuickAddRequest, opts ...grpc.CallOption) (*QuickAddResponse, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	CreateMerchant(ctx context.Context, in *CreateMerchantRequest, opts ...grpc.CallOption) (*MerchantResponse, error)
+	AddMerchantAlias(ctx context.Context, in *MerchantAliasRequest, opts ...grpc.CallOption) (*MerchantResponse, error)
+	ListMerchants(ctx context.Context, in *ListMerchantsRequest, opts ...grpc.CallOption) (*MerchantList, error)
+	GetTopMerchants(ctx context.Context, in *TopMerchantsRequest, opts ...grpc.CallOption) (*TopMerchantsResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*SubscriptionList, error)
+	CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*DebtResponse, error)
+	RecordDebtPayment(ctx context.Context, in *DebtPaymentRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	ListDebts(ctx context.Context, in *ListDebtsRequest, opts ...grpc.CallOption) (*DebtList, error)
+	GetDebtSchedule(ctx context.Context, in *DebtScheduleRequest, opts ...grpc.CallOption) (*DebtSchedule, error)
+	RecordIncome(ctx context.Context, in *IncomeRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	MoveEnvelope(ctx context.Context, in *EnvelopeMoveRequest, opts ...grpc.CallOption) (*EnvelopeResponse, error)
+	GetEnvelopes(ctx context.Context, in *GetEnvelopesRequest, opts ...grpc.CallOption) (*EnvelopeSummary, error)
+	CreateExpenseReport(ctx context.Context, in *CreateExpenseReportRequest, opts ...grpc.CallOption) (*ExpenseReportResponse, error)
+	SetExpenseReportStatus(ctx context.Context, in *ExpenseReportStatusRequest, opts ...grpc.CallOption) (*ExpenseReportResponse, error)
+	RemoveExpenseReportItem(ctx context.Context, in *ExpenseReportItemRequest, opts ...grpc.CallOption) (*ExpenseReportResponse, error)
+	ReimburseExpenseReport(ctx context.Context, in *ReimburseRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	ListExpenseReports(ctx context.Context, in *ListExpenseReportsRequest, opts ...grpc.CallOption) (*ExpenseReportList, error)
+	RecordRefund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	SetRefundAttribution(ctx context.Context, in *SetRefundAttributionRequest, opts ...grpc.CallOption) (*SettingsResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*TransactionList, error)
 }
 
 type ledgerServiceClient struct {
@@ -84,126 +185,1751 @@ func (c *ledgerServiceClient) GetBudgets(ctx context.Context, in *GetBudgetsRequ
 	return out, nil
 }
 
+func (c *ledgerServiceClient) GetBudgetHistory(ctx context.Context, in *BudgetHistoryRequest, opts ...grpc.CallOption) (*BudgetHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetHistory)
+	err := c.cc.Invoke(ctx, LedgerService_GetBudgetHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListBudgetLimits(ctx context.Context, in *BudgetLimitsRequest, opts ...grpc.CallOption) (*BudgetLimitList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetLimitList)
+	err := c.cc.Invoke(ctx, LedgerService_ListBudgetLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SaveBudgetTemplate(ctx context.Context, in *BudgetTemplateRequest, opts ...grpc.CallOption) (*BudgetTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetTemplateResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SaveBudgetTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListBudgetTemplates(ctx context.Context, in *ListBudgetTemplatesRequest, opts ...grpc.CallOption) (*BudgetTemplateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BudgetTemplateList)
+	err := c.cc.Invoke(ctx, LedgerService_ListBudgetTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ApplyBudgets(ctx context.Context, in *ApplyBudgetsRequest, opts ...grpc.CallOption) (*ApplyBudgetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyBudgetsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ApplyBudgets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetCategoryGroup(ctx context.Context, in *CategoryGroupRequest, opts ...grpc.CallOption) (*CategoryGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryGroupResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SetCategoryGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListCategoryGroups(ctx context.Context, in *ListCategoryGroupsRequest, opts ...grpc.CallOption) (*CategoryGroupList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryGroupList)
+	err := c.cc.Invoke(ctx, LedgerService_ListCategoryGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetForecast(ctx context.Context, in *ForecastRequest, opts ...grpc.CallOption) (*ForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ForecastResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListAnomalies(ctx context.Context, in *ListAnomaliesRequest, opts ...grpc.CallOption) (*AnomalyList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnomalyList)
+	err := c.cc.Invoke(ctx, LedgerService_ListAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ReviewAnomaly(ctx context.Context, in *ReviewAnomalyRequest, opts ...grpc.CallOption) (*ReviewAnomalyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewAnomalyResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ReviewAnomaly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CompareReport(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CompareReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetPivotReport(ctx context.Context, in *PivotRequest, opts ...grpc.CallOption) (*PivotReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PivotReport)
+	err := c.cc.Invoke(ctx, LedgerService_GetPivotReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetStatistics(ctx context.Context, in *StatisticsRequest, opts ...grpc.CallOption) (*StatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatisticsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*UserSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSettings)
+	err := c.cc.Invoke(ctx, LedgerService_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetTimezone(ctx context.Context, in *SetTimezoneRequest, opts ...grpc.CallOption) (*SettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SetTimezone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetBudgetMode(ctx context.Context, in *SetBudgetModeRequest, opts ...grpc.CallOption) (*SettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SetBudgetMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateTransactions(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BatchTransactionItem, BatchTransactionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LedgerService_ServiceDesc.Streams[0], LedgerService_CreateTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchTransactionItem, BatchTransactionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_CreateTransactionsClient = grpc.ClientStreamingClient[BatchTransactionItem, BatchTransactionResponse]
+
+func (c *ledgerServiceClient) WatchLedger(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LedgerEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LedgerService_ServiceDesc.Streams[1], LedgerService_WatchLedger_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchRequest, LedgerEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_WatchLedgerClient = grpc.ServerStreamingClient[LedgerEvent]
+
+func (c *ledgerServiceClient) UpdateTransaction(ctx context.Context, in *UpdateTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_DeleteTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SyncPush(ctx context.Context, in *SyncPushRequest, opts ...grpc.CallOption) (*SyncPushResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncPushResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SyncPush_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetChanges(ctx context.Context, in *ChangesRequest, opts ...grpc.CallOption) (*ChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateGoal(ctx context.Context, in *CreateGoalRequest, opts ...grpc.CallOption) (*GoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoalResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) Contribute(ctx context.Context, in *ContributionRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_Contribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetGoals(ctx context.Context, in *GetGoalsRequest, opts ...grpc.CallOption) (*GoalList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GoalList)
+	err := c.cc.Invoke(ctx, LedgerService_GetGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UploadAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAttachment(ctx context.Context, in *GetAttachmentRequest, opts ...grpc.CallOption) (*AttachmentData, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentData)
+	err := c.cc.Invoke(ctx, LedgerService_GetAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*AttachmentList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttachmentList)
+	err := c.cc.Invoke(ctx, LedgerService_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateFromReceipt(ctx context.Context, in *ReceiptRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateFromReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) QuickAdd(ctx context.Context, in *QuickAddRequest, opts ...grpc.CallOption) (*QuickAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuickAddResponse)
+	err := c.cc.Invoke(ctx, LedgerService_QuickAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, LedgerService_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateMerchant(ctx context.Context, in *CreateMerchantRequest, opts ...grpc.CallOption) (*MerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MerchantResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateMerchant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) AddMerchantAlias(ctx context.Context, in *MerchantAliasRequest, opts ...grpc.CallOption) (*MerchantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MerchantResponse)
+	err := c.cc.Invoke(ctx, LedgerService_AddMerchantAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListMerchants(ctx context.Context, in *ListMerchantsRequest, opts ...grpc.CallOption) (*MerchantList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MerchantList)
+	err := c.cc.Invoke(ctx, LedgerService_ListMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetTopMerchants(ctx context.Context, in *TopMerchantsRequest, opts ...grpc.CallOption) (*TopMerchantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopMerchantsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetTopMerchants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*SubscriptionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionList)
+	err := c.cc.Invoke(ctx, LedgerService_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateDebt(ctx context.Context, in *CreateDebtRequest, opts ...grpc.CallOption) (*DebtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebtResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateDebt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) RecordDebtPayment(ctx context.Context, in *DebtPaymentRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_RecordDebtPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListDebts(ctx context.Context, in *ListDebtsRequest, opts ...grpc.CallOption) (*DebtList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebtList)
+	err := c.cc.Invoke(ctx, LedgerService_ListDebts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetDebtSchedule(ctx context.Context, in *DebtScheduleRequest, opts ...grpc.CallOption) (*DebtSchedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebtSchedule)
+	err := c.cc.Invoke(ctx, LedgerService_GetDebtSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) RecordIncome(ctx context.Context, in *IncomeRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_RecordIncome_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) MoveEnvelope(ctx context.Context, in *EnvelopeMoveRequest, opts ...grpc.CallOption) (*EnvelopeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvelopeResponse)
+	err := c.cc.Invoke(ctx, LedgerService_MoveEnvelope_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetEnvelopes(ctx context.Context, in *GetEnvelopesRequest, opts ...grpc.CallOption) (*EnvelopeSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvelopeSummary)
+	err := c.cc.Invoke(ctx, LedgerService_GetEnvelopes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateExpenseReport(ctx context.Context, in *CreateExpenseReportRequest, opts ...grpc.CallOption) (*ExpenseReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateExpenseReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetExpenseReportStatus(ctx context.Context, in *ExpenseReportStatusRequest, opts ...grpc.CallOption) (*ExpenseReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SetExpenseReportStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) RemoveExpenseReportItem(ctx context.Context, in *ExpenseReportItemRequest, opts ...grpc.CallOption) (*ExpenseReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseReportResponse)
+	err := c.cc.Invoke(ctx, LedgerService_RemoveExpenseReportItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ReimburseExpenseReport(ctx context.Context, in *ReimburseRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ReimburseExpenseReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListExpenseReports(ctx context.Context, in *ListExpenseReportsRequest, opts ...grpc.CallOption) (*ExpenseReportList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseReportList)
+	err := c.cc.Invoke(ctx, LedgerService_ListExpenseReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) RecordRefund(ctx context.Context, in *RefundRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionResponse)
+	err := c.cc.Invoke(ctx, LedgerService_RecordRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) SetRefundAttribution(ctx context.Context, in *SetRefundAttributionRequest, opts ...grpc.CallOption) (*SettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_SetRefundAttribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*TransactionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionList)
+	err := c.cc.Invoke(ctx, LedgerService_ListTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerServiceServer is the server API for LedgerService service.
 // All implementations must embed UnimplementedLedgerServiceServer
 // for forward compatibility.
 type LedgerServiceServer interface {
 	CreateTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error)
 	GetReport(context.Context, *ReportRequest) (*ReportResponse, error)
-	// === НОВЫЕ МЕТОДЫ ДЛЯ БЮДЖЕТОВ ===
 	SetBudget(context.Context, *BudgetRequest) (*BudgetResponse, error)
 	GetBudgets(context.Context, *GetBudgetsRequest) (*BudgetList, error)
+	GetBudgetHistory(context.Context, *BudgetHistoryRequest) (*BudgetHistory, error)
+	ListBudgetLimits(context.Context, *BudgetLimitsRequest) (*BudgetLimitList, error)
+	SaveBudgetTemplate(context.Context, *BudgetTemplateRequest) (*BudgetTemplateResponse, error)
+	ListBudgetTemplates(context.Context, *ListBudgetTemplatesRequest) (*BudgetTemplateList, error)
+	ApplyBudgets(context.Context, *ApplyBudgetsRequest) (*ApplyBudgetsResponse, error)
+	SetCategoryGroup(context.Context, *CategoryGroupRequest) (*CategoryGroupResponse, error)
+	ListCategoryGroups(context.Context, *ListCategoryGroupsRequest) (*CategoryGroupList, error)
+	GetForecast(context.Context, *ForecastRequest) (*ForecastResponse, error)
+	ListAnomalies(context.Context, *ListAnomaliesRequest) (*AnomalyList, error)
+	ReviewAnomaly(context.Context, *ReviewAnomalyRequest) (*ReviewAnomalyResponse, error)
+	CompareReport(context.Context, *CompareRequest) (*CompareResponse, error)
+	GetPivotReport(context.Context, *PivotRequest) (*PivotReport, error)
+	GetStatistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error)
+	GetSettings(context.Context, *GetSettingsRequest) (*UserSettings, error)
+	SetTimezone(context.Context, *SetTimezoneRequest) (*SettingsResponse, error)
+	SetBudgetMode(context.Context, *SetBudgetModeRequest) (*SettingsResponse, error)
+	CreateTransactions(grpc.ClientStreamingServer[BatchTransactionItem, BatchTransactionResponse]) error
+	WatchLedger(*WatchRequest, grpc.ServerStreamingServer[LedgerEvent]) error
+	UpdateTransaction(context.Context, *UpdateTransactionRequest) (*TransactionResponse, error)
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*TransactionResponse, error)
+	SyncPush(context.Context, *SyncPushRequest) (*SyncPushResponse, error)
+	GetChanges(context.Context, *ChangesRequest) (*ChangesResponse, error)
+	CreateGoal(context.Context, *CreateGoalRequest) (*GoalResponse, error)
+	Contribute(context.Context, *ContributionRequest) (*TransactionResponse, error)
+	GetGoals(context.Context, *GetGoalsRequest) (*GoalList, error)
+	UploadAttachment(context.Context, *UploadAttachmentRequest) (*AttachmentResponse, error)
+	GetAttachment(context.Context, *GetAttachmentRequest) (*AttachmentData, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*AttachmentList, error)
+	CreateFromReceipt(context.Context, *ReceiptRequest) (*TransactionResponse, error)
+	QuickAdd(context.Context, *QuickAddRequest) (*QuickAddResponse, error)
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	CreateMerchant(context.Context, *CreateMerchantRequest) (*MerchantResponse, error)
+	AddMerchantAlias(context.Context, *MerchantAliasRequest) (*MerchantResponse, error)
+	ListMerchants(context.Context, *ListMerchantsRequest) (*MerchantList, error)
+	GetTopMerchants(context.Context, *TopMerchantsRequest) (*TopMerchantsResponse, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*SubscriptionList, error)
+	CreateDebt(context.Context, *CreateDebtRequest) (*DebtResponse, error)
+	RecordDebtPayment(context.Context, *DebtPaymentRequest) (*TransactionResponse, error)
+	ListDebts(context.Context, *ListDebtsRequest) (*DebtList, error)
+	GetDebtSchedule(context.Context, *DebtScheduleRequest) (*DebtSchedule, error)
+	RecordIncome(context.Context, *IncomeRequest) (*TransactionResponse, error)
+	MoveEnvelope(context.Context, *EnvelopeMoveRequest) (*EnvelopeResponse, error)
+	GetEnvelopes(context.Context, *GetEnvelopesRequest) (*EnvelopeSummary, error)
+	CreateExpenseReport(context.Context, *CreateExpenseReportRequest) (*ExpenseReportResponse, error)
+	SetExpenseReportStatus(context.Context, *ExpenseReportStatusRequest) (*ExpenseReportResponse, error)
+	RemoveExpenseReportItem(context.Context, *ExpenseReportItemRequest) (*ExpenseReportResponse, error)
+	ReimburseExpenseReport(context.Context, *ReimburseRequest) (*TransactionResponse, error)
+	ListExpenseReports(context.Context, *ListExpenseReportsRequest) (*ExpenseReportList, error)
+	RecordRefund(context.Context, *RefundRequest) (*TransactionResponse, error)
+	SetRefundAttribution(context.Context, *SetRefundAttributionRequest) (*SettingsResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*TransactionList, error)
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+// UnimplementedLedgerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServiceServer struct{}
+
+func (UnimplementedLedgerServiceServer) CreateTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetReport(context.Context, *ReportRequest) (*ReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetBudget(context.Context, *BudgetRequest) (*BudgetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetBudgets(context.Context, *GetBudgetsRequest) (*BudgetList, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgets not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetBudgetHistory(context.Context, *BudgetHistoryRequest) (*BudgetHistory, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBudgetHistory not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListBudgetLimits(context.Context, *BudgetLimitsRequest) (*BudgetLimitList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBudgetLimits not implemented")
+}
+func (UnimplementedLedgerServiceServer) SaveBudgetTemplate(context.Context, *BudgetTemplateRequest) (*BudgetTemplateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SaveBudgetTemplate not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListBudgetTemplates(context.Context, *ListBudgetTemplatesRequest) (*BudgetTemplateList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBudgetTemplates not implemented")
+}
+func (UnimplementedLedgerServiceServer) ApplyBudgets(context.Context, *ApplyBudgetsRequest) (*ApplyBudgetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyBudgets not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetCategoryGroup(context.Context, *CategoryGroupRequest) (*CategoryGroupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCategoryGroup not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListCategoryGroups(context.Context, *ListCategoryGroupsRequest) (*CategoryGroupList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCategoryGroups not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetForecast(context.Context, *ForecastRequest) (*ForecastResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetForecast not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListAnomalies(context.Context, *ListAnomaliesRequest) (*AnomalyList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAnomalies not implemented")
+}
+func (UnimplementedLedgerServiceServer) ReviewAnomaly(context.Context, *ReviewAnomalyRequest) (*ReviewAnomalyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewAnomaly not implemented")
+}
+func (UnimplementedLedgerServiceServer) CompareReport(context.Context, *CompareRequest) (*CompareResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetPivotReport(context.Context, *PivotRequest) (*PivotReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPivotReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetStatistics(context.Context, *StatisticsRequest) (*StatisticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStatistics not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetSettings(context.Context, *GetSettingsRequest) (*UserSettings, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetTimezone(context.Context, *SetTimezoneRequest) (*SettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTimezone not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetBudgetMode(context.Context, *SetBudgetModeRequest) (*SettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBudgetMode not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateTransactions(grpc.ClientStreamingServer[BatchTransactionItem, BatchTransactionResponse]) error {
+	return status.Error(codes.Unimplemented, "method CreateTransactions not implemented")
+}
+func (UnimplementedLedgerServiceServer) WatchLedger(*WatchRequest, grpc.ServerStreamingServer[LedgerEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchLedger not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateTransaction(context.Context, *UpdateTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedLedgerServiceServer) SyncPush(context.Context, *SyncPushRequest) (*SyncPushResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncPush not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetChanges(context.Context, *ChangesRequest) (*ChangesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChanges not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateGoal(context.Context, *CreateGoalRequest) (*GoalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGoal not implemented")
+}
+func (UnimplementedLedgerServiceServer) Contribute(context.Context, *ContributionRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Contribute not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetGoals(context.Context, *GetGoalsRequest) (*GoalList, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGoals not implemented")
+}
+func (UnimplementedLedgerServiceServer) UploadAttachment(context.Context, *UploadAttachmentRequest) (*AttachmentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAttachment(context.Context, *GetAttachmentRequest) (*AttachmentData, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*AttachmentList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateFromReceipt(context.Context, *ReceiptRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFromReceipt not implemented")
+}
+func (UnimplementedLedgerServiceServer) QuickAdd(context.Context, *QuickAddRequest) (*QuickAddResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QuickAdd not implemented")
+}
+func (UnimplementedLedgerServiceServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateMerchant(context.Context, *CreateMerchantRequest) (*MerchantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMerchant not implemented")
+}
+func (UnimplementedLedgerServiceServer) AddMerchantAlias(context.Context, *MerchantAliasRequest) (*MerchantResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddMerchantAlias not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListMerchants(context.Context, *ListMerchantsRequest) (*MerchantList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMerchants not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetTopMerchants(context.Context, *TopMerchantsRequest) (*TopMerchantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTopMerchants not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*SubscriptionList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateDebt(context.Context, *CreateDebtRequest) (*DebtResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateDebt not implemented")
+}
+func (UnimplementedLedgerServiceServer) RecordDebtPayment(context.Context, *DebtPaymentRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordDebtPayment not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListDebts(context.Context, *ListDebtsRequest) (*DebtList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDebts not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetDebtSchedule(context.Context, *DebtScheduleRequest) (*DebtSchedule, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDebtSchedule not implemented")
+}
+func (UnimplementedLedgerServiceServer) RecordIncome(context.Context, *IncomeRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordIncome not implemented")
+}
+func (UnimplementedLedgerServiceServer) MoveEnvelope(context.Context, *EnvelopeMoveRequest) (*EnvelopeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveEnvelope not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetEnvelopes(context.Context, *GetEnvelopesRequest) (*EnvelopeSummary, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetEnvelopes not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateExpenseReport(context.Context, *CreateExpenseReportRequest) (*ExpenseReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateExpenseReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetExpenseReportStatus(context.Context, *ExpenseReportStatusRequest) (*ExpenseReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetExpenseReportStatus not implemented")
+}
+func (UnimplementedLedgerServiceServer) RemoveExpenseReportItem(context.Context, *ExpenseReportItemRequest) (*ExpenseReportResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveExpenseReportItem not implemented")
+}
+func (UnimplementedLedgerServiceServer) ReimburseExpenseReport(context.Context, *ReimburseRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReimburseExpenseReport not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListExpenseReports(context.Context, *ListExpenseReportsRequest) (*ExpenseReportList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListExpenseReports not implemented")
+}
+func (UnimplementedLedgerServiceServer) RecordRefund(context.Context, *RefundRequest) (*TransactionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordRefund not implemented")
+}
+func (UnimplementedLedgerServiceServer) SetRefundAttribution(context.Context, *SetRefundAttributionRequest) (*SettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRefundAttribution not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*TransactionList, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
+func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServiceServer will
+// result in compilation errors.
+type UnsafeLedgerServiceServer interface {
 	mustEmbedUnimplementedLedgerServiceServer()
 }
 
-// UnimplementedLedgerServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedLedgerServiceServer struct{}
+func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
+	// If the following call panics, it indicates UnimplementedLedgerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LedgerService_ServiceDesc, srv)
+}
+
+func _LedgerService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetReport(ctx, req.(*ReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetBudget(ctx, req.(*BudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetBudgets(ctx, req.(*GetBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetBudgetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetBudgetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetBudgetHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetBudgetHistory(ctx, req.(*BudgetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListBudgetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListBudgetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListBudgetLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListBudgetLimits(ctx, req.(*BudgetLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SaveBudgetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BudgetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SaveBudgetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SaveBudgetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SaveBudgetTemplate(ctx, req.(*BudgetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListBudgetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBudgetTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListBudgetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListBudgetTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListBudgetTemplates(ctx, req.(*ListBudgetTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ApplyBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ApplyBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ApplyBudgets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ApplyBudgets(ctx, req.(*ApplyBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetCategoryGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetCategoryGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetCategoryGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetCategoryGroup(ctx, req.(*CategoryGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListCategoryGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListCategoryGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListCategoryGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListCategoryGroups(ctx, req.(*ListCategoryGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetForecast(ctx, req.(*ForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListAnomalies(ctx, req.(*ListAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ReviewAnomaly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAnomalyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ReviewAnomaly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ReviewAnomaly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ReviewAnomaly(ctx, req.(*ReviewAnomalyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CompareReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CompareReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CompareReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CompareReport(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetPivotReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PivotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetPivotReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetPivotReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetPivotReport(ctx, req.(*PivotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetStatistics(ctx, req.(*StatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetSettings(ctx, req.(*GetSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetTimezone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTimezoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetTimezone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetTimezone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetTimezone(ctx, req.(*SetTimezoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SetBudgetMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetBudgetMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetBudgetMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetBudgetMode(ctx, req.(*SetBudgetModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LedgerServiceServer).CreateTransactions(&grpc.GenericServerStream[BatchTransactionItem, BatchTransactionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_CreateTransactionsServer = grpc.ClientStreamingServer[BatchTransactionItem, BatchTransactionResponse]
+
+func _LedgerService_WatchLedger_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LedgerServiceServer).WatchLedger(m, &grpc.GenericServerStream[WatchRequest, LedgerEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_WatchLedgerServer = grpc.ServerStreamingServer[LedgerEvent]
+
+func _LedgerService_UpdateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateTransaction(ctx, req.(*UpdateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_DeleteTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_SyncPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SyncPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SyncPush_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SyncPush(ctx, req.(*SyncPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetChanges(ctx, req.(*ChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateGoal(ctx, req.(*CreateGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_Contribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).Contribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_Contribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).Contribute(ctx, req.(*ContributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetGoals(ctx, req.(*GetGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UploadAttachment(ctx, req.(*UploadAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAttachment(ctx, req.(*GetAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateFromReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateFromReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateFromReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateFromReceipt(ctx, req.(*ReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_QuickAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuickAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).QuickAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_QuickAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).QuickAdd(ctx, req.(*QuickAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateMerchant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateMerchant(ctx, req.(*CreateMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_AddMerchantAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MerchantAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).AddMerchantAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_AddMerchantAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).AddMerchantAlias(ctx, req.(*MerchantAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListMerchants(ctx, req.(*ListMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetTopMerchants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopMerchantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetTopMerchants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetTopMerchants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetTopMerchants(ctx, req.(*TopMerchantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDebtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateDebt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateDebt(ctx, req.(*CreateDebtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_RecordDebtPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebtPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).RecordDebtPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_RecordDebtPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).RecordDebtPayment(ctx, req.(*DebtPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListDebts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDebtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListDebts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListDebts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListDebts(ctx, req.(*ListDebtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetDebtSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebtScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetDebtSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetDebtSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetDebtSchedule(ctx, req.(*DebtScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedLedgerServiceServer) CreateTransaction(context.Context, *TransactionRequest) (*TransactionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateTransaction not implemented")
+func _LedgerService_RecordIncome_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncomeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).RecordIncome(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_RecordIncome_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).RecordIncome(ctx, req.(*IncomeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) GetReport(context.Context, *ReportRequest) (*ReportResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetReport not implemented")
+
+func _LedgerService_MoveEnvelope_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnvelopeMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).MoveEnvelope(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_MoveEnvelope_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).MoveEnvelope(ctx, req.(*EnvelopeMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) SetBudget(context.Context, *BudgetRequest) (*BudgetResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SetBudget not implemented")
+
+func _LedgerService_GetEnvelopes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvelopesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetEnvelopes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetEnvelopes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetEnvelopes(ctx, req.(*GetEnvelopesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) GetBudgets(context.Context, *GetBudgetsRequest) (*BudgetList, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetBudgets not implemented")
+
+func _LedgerService_CreateExpenseReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExpenseReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateExpenseReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateExpenseReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateExpenseReport(ctx, req.(*CreateExpenseReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
-func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to LedgerServiceServer will
-// result in compilation errors.
-type UnsafeLedgerServiceServer interface {
-	mustEmbedUnimplementedLedgerServiceServer()
+func _LedgerService_SetExpenseReportStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseReportStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).SetExpenseReportStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_SetExpenseReportStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).SetExpenseReportStatus(ctx, req.(*ExpenseReportStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
-	// If the following call panics, it indicates UnimplementedLedgerServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _LedgerService_RemoveExpenseReportItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseReportItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&LedgerService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).RemoveExpenseReportItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_RemoveExpenseReportItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).RemoveExpenseReportItem(ctx, req.(*ExpenseReportItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _LedgerService_ReimburseExpenseReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReimburseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).CreateTransaction(ctx, in)
+		return srv.(LedgerServiceServer).ReimburseExpenseReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_CreateTransaction_FullMethodName,
+		FullMethod: LedgerService_ReimburseExpenseReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).CreateTransaction(ctx, req.(*TransactionRequest))
+		return srv.(LedgerServiceServer).ReimburseExpenseReport(ctx, req.(*ReimburseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_GetReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportRequest)
+func _LedgerService_ListExpenseReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpenseReportsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetReport(ctx, in)
+		return srv.(LedgerServiceServer).ListExpenseReports(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_GetReport_FullMethodName,
+		FullMethod: LedgerService_ListExpenseReports_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetReport(ctx, req.(*ReportRequest))
+		return srv.(LedgerServiceServer).ListExpenseReports(ctx, req.(*ListExpenseReportsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BudgetRequest)
+func _LedgerService_RecordRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).SetBudget(ctx, in)
+		return srv.(LedgerServiceServer).RecordRefund(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_SetBudget_FullMethodName,
+		FullMethod: LedgerService_RecordRefund_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).SetBudget(ctx, req.(*BudgetRequest))
+		return srv.(LedgerServiceServer).RecordRefund(ctx, req.(*RefundRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LedgerService_GetBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBudgetsRequest)
+func _LedgerService_SetRefundAttribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRefundAttributionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LedgerServiceServer).GetBudgets(ctx, in)
+		return srv.(LedgerServiceServer).SetRefundAttribution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: LedgerService_GetBudgets_FullMethodName,
+		FullMethod: LedgerService_SetRefundAttribution_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LedgerServiceServer).GetBudgets(ctx, req.(*GetBudgetsRequest))
+		return srv.(LedgerServiceServer).SetRefundAttribution(ctx, req.(*SetRefundAttributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -231,7 +1957,214 @@ var LedgerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBudgets",
 			Handler:    _LedgerService_GetBudgets_Handler,
 		},
+		{
+			MethodName: "GetBudgetHistory",
+			Handler:    _LedgerService_GetBudgetHistory_Handler,
+		},
+		{
+			MethodName: "ListBudgetLimits",
+			Handler:    _LedgerService_ListBudgetLimits_Handler,
+		},
+		{
+			MethodName: "SaveBudgetTemplate",
+			Handler:    _LedgerService_SaveBudgetTemplate_Handler,
+		},
+		{
+			MethodName: "ListBudgetTemplates",
+			Handler:    _LedgerService_ListBudgetTemplates_Handler,
+		},
+		{
+			MethodName: "ApplyBudgets",
+			Handler:    _LedgerService_ApplyBudgets_Handler,
+		},
+		{
+			MethodName: "SetCategoryGroup",
+			Handler:    _LedgerService_SetCategoryGroup_Handler,
+		},
+		{
+			MethodName: "ListCategoryGroups",
+			Handler:    _LedgerService_ListCategoryGroups_Handler,
+		},
+		{
+			MethodName: "GetForecast",
+			Handler:    _LedgerService_GetForecast_Handler,
+		},
+		{
+			MethodName: "ListAnomalies",
+			Handler:    _LedgerService_ListAnomalies_Handler,
+		},
+		{
+			MethodName: "ReviewAnomaly",
+			Handler:    _LedgerService_ReviewAnomaly_Handler,
+		},
+		{
+			MethodName: "CompareReport",
+			Handler:    _LedgerService_CompareReport_Handler,
+		},
+		{
+			MethodName: "GetPivotReport",
+			Handler:    _LedgerService_GetPivotReport_Handler,
+		},
+		{
+			MethodName: "GetStatistics",
+			Handler:    _LedgerService_GetStatistics_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _LedgerService_GetSettings_Handler,
+		},
+		{
+			MethodName: "SetTimezone",
+			Handler:    _LedgerService_SetTimezone_Handler,
+		},
+		{
+			MethodName: "SetBudgetMode",
+			Handler:    _LedgerService_SetBudgetMode_Handler,
+		},
+		{
+			MethodName: "UpdateTransaction",
+			Handler:    _LedgerService_UpdateTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _LedgerService_DeleteTransaction_Handler,
+		},
+		{
+			MethodName: "SyncPush",
+			Handler:    _LedgerService_SyncPush_Handler,
+		},
+		{
+			MethodName: "GetChanges",
+			Handler:    _LedgerService_GetChanges_Handler,
+		},
+		{
+			MethodName: "CreateGoal",
+			Handler:    _LedgerService_CreateGoal_Handler,
+		},
+		{
+			MethodName: "Contribute",
+			Handler:    _LedgerService_Contribute_Handler,
+		},
+		{
+			MethodName: "GetGoals",
+			Handler:    _LedgerService_GetGoals_Handler,
+		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _LedgerService_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _LedgerService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _LedgerService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "CreateFromReceipt",
+			Handler:    _LedgerService_CreateFromReceipt_Handler,
+		},
+		{
+			MethodName: "QuickAdd",
+			Handler:    _LedgerService_QuickAdd_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _LedgerService_Search_Handler,
+		},
+		{
+			MethodName: "CreateMerchant",
+			Handler:    _LedgerService_CreateMerchant_Handler,
+		},
+		{
+			MethodName: "AddMerchantAlias",
+			Handler:    _LedgerService_AddMerchantAlias_Handler,
+		},
+		{
+			MethodName: "ListMerchants",
+			Handler:    _LedgerService_ListMerchants_Handler,
+		},
+		{
+			MethodName: "GetTopMerchants",
+			Handler:    _LedgerService_GetTopMerchants_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _LedgerService_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateDebt",
+			Handler:    _LedgerService_CreateDebt_Handler,
+		},
+		{
+			MethodName: "RecordDebtPayment",
+			Handler:    _LedgerService_RecordDebtPayment_Handler,
+		},
+		{
+			MethodName: "ListDebts",
+			Handler:    _LedgerService_ListDebts_Handler,
+		},
+		{
+			MethodName: "GetDebtSchedule",
+			Handler:    _LedgerService_GetDebtSchedule_Handler,
+		},
+		{
+			MethodName: "RecordIncome",
+			Handler:    _LedgerService_RecordIncome_Handler,
+		},
+		{
+			MethodName: "MoveEnvelope",
+			Handler:    _LedgerService_MoveEnvelope_Handler,
+		},
+		{
+			MethodName: "GetEnvelopes",
+			Handler:    _LedgerService_GetEnvelopes_Handler,
+		},
+		{
+			MethodName: "CreateExpenseReport",
+			Handler:    _LedgerService_CreateExpenseReport_Handler,
+		},
+		{
+			MethodName: "SetExpenseReportStatus",
+			Handler:    _LedgerService_SetExpenseReportStatus_Handler,
+		},
+		{
+			MethodName: "RemoveExpenseReportItem",
+			Handler:    _LedgerService_RemoveExpenseReportItem_Handler,
+		},
+		{
+			MethodName: "ReimburseExpenseReport",
+			Handler:    _LedgerService_ReimburseExpenseReport_Handler,
+		},
+		{
+			MethodName: "ListExpenseReports",
+			Handler:    _LedgerService_ListExpenseReports_Handler,
+		},
+		{
+			MethodName: "RecordRefund",
+			Handler:    _LedgerService_RecordRefund_Handler,
+		},
+		{
+			MethodName: "SetRefundAttribution",
+			Handler:    _LedgerService_SetRefundAttribution_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _LedgerService_ListTransactions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CreateTransactions",
+			Handler:       _LedgerService_CreateTransactions_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchLedger",
+			Handler:       _LedgerService_WatchLedger_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/ledger.proto",
 }
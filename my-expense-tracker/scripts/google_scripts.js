@@ -6,11 +6,36 @@ function onOpen() {
     .addItem('Регистрация', 'registerUser')
     .addItem('Войти', 'loginUser')
     .addItem('Отправить строку', 'sendTransaction')
+    .addItem('Отправить все новые строки', 'sendAllTransactions')
+    .addItem('Синхронизировать', 'syncSheet')
+    .addItem('Добавить чек по QR-коду', 'sendReceipt')
+    .addItem('Быстрый ввод', 'quickAdd')
     .addItem('Выйти', 'logoutUser')
     .addSeparator()
     .addItem('Получить отчет', 'getReport')
     .addItem('Установить бюджет', 'setBudget')
     .addItem('Получить бюджет', 'getBudgets')
+    .addItem('Сохранить бюджеты как шаблон', 'saveBudgetTemplate')
+    .addItem('Применить шаблон бюджетов', 'applyBudgets')
+    .addItem('Группа категорий', 'setCategoryGroup')
+    .addItem('Сводная таблица', 'getPivot')
+    .addItem('Поиск по описанию', 'searchTransactions')
+    .addItem('Цели накоплений', 'getGoals')
+    .addItem('Подписки', 'getSubscriptions')
+    .addItem('Долги', 'getDebts')
+    .addSeparator()
+    .addItem('Записать доход', 'recordIncome')
+    .addItem('Записать возврат', 'recordRefund')
+    .addItem('Учет возвратов', 'setRefundAttribution')
+    .addItem('Конверты', 'getEnvelopes')
+    .addItem('Распределить по конвертам', 'moveEnvelope')
+    .addItem('Режим бюджета', 'setBudgetMode')
+    .addSeparator()
+    .addItem('Отчеты о расходах', 'getExpenseReports')
+    .addItem('Создать отчет о расходах', 'createExpenseReport')
+    .addItem('Изменить статус отчета', 'setExpenseReportStatus')
+    .addItem('Убрать трату из отчета', 'removeExpenseReportItem')
+    .addItem('Записать возмещение', 'reimburseExpenseReport')
     .addToUi();
 }
 
@@ -66,6 +91,7 @@ function loginUser() {
 
       if (json.token) {
         PropertiesService.getScriptProperties().setProperty('JWT_TOKEN', json.token);
+        syncTimezone(json.token);
         ui.alert("Успешный вход!");
       } else {
         ui.alert("Ошибка входа: " + response.getContentText());
@@ -79,6 +105,19 @@ function loginUser() {
   }
 }
 
+// Часовой пояс таблицы становится часовым поясом пользователя: по нему
+// считаются границы месяцев в отчетах и бюджетах.
+function syncTimezone(token) {
+  const options = {
+    'method': 'post',
+    'contentType': 'application/json',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'payload': JSON.stringify({ timezone: SpreadsheetApp.getActive().getSpreadsheetTimeZone() }),
+    'muteHttpExceptions': true
+  };
+  UrlFetchApp.fetch(BASE_URL + "/settings/timezone", options);
+}
+
 function sendTransaction() {
   const sheet = SpreadsheetApp.getActiveSpreadsheet().getActiveSheet();
   const ui = SpreadsheetApp.getUi();
@@ -91,6 +130,7 @@ function sendTransaction() {
   const amount = sheet.getRange(row, 1).getValue();
   const category = sheet.getRange(row, 2).getValue();
   const desc = sheet.getRange(row, 3).getValue();
+  const date = sheet.getRange(row, 5).getValue();
 
   if (amount === "" || category === "") {
     ui.alert("Заполните Сумму и Категорию!");
@@ -102,12 +142,16 @@ function sendTransaction() {
     category: category.toString(),
     description: desc.toString()
   };
+  if (date instanceof Date) {
+    payload.occurred_at = Utilities.formatDate(date, SpreadsheetApp.getActive().getSpreadsheetTimeZone(), "yyyy-MM-dd'T'HH:mm:ss");
+  }
 
   const options = {
     'method': 'post',
     'contentType': 'application/json',
     'headers': {
       'Authorization': token,
+      'Idempotency-Key': Utilities.getUuid(),
       'ngrok-skip-browser-warning': 'true'
     },
     'payload': JSON.stringify(payload),
@@ -115,7 +159,7 @@ function sendTransaction() {
   };
 
   try {
-    const response = UrlFetchApp.fetch(BASE_URL + "/transaction", options);
+    const response = fetchWithRetry(BASE_URL + "/transaction", options, 3);
     const textResponse = response.getContentText();
     
     let json;
@@ -144,6 +188,275 @@ function sendTransaction() {
   }
 }
 
+// Отправляет одним запросом все строки, у которых еще нет статуса в колонке 4.
+// Каждой строке присваивается UUID в колонке 6, поэтому повторная отправка
+// после сбоя не создаст дубликатов.
+function sendAllTransactions() {
+  const sheet = SpreadsheetApp.getActiveSpreadsheet().getActiveSheet();
+  const ui = SpreadsheetApp.getUi();
+
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Сначала войдите в систему!"); return; }
+
+  const lastRow = sheet.getLastRow();
+  if (lastRow < 2) return;
+
+  const tz = SpreadsheetApp.getActive().getSpreadsheetTimeZone();
+  const values = sheet.getRange(2, 1, lastRow - 1, 6).getValues();
+  const rows = [];
+  values.forEach((v, i) => {
+    if (v[0] === "" || v[1] === "" || v[3] !== "") return;
+    let uuid = v[5].toString();
+    if (!uuid) {
+      uuid = Utilities.getUuid();
+      sheet.getRange(i + 2, 6).setValue(uuid);
+    }
+    const row = {
+      row: i + 2,
+      amount: parseFloat(v[0]),
+      category: v[1].toString(),
+      description: v[2].toString(),
+      row_uuid: uuid
+    };
+    if (v[4] instanceof Date) {
+      row.occurred_at = Utilities.formatDate(v[4], tz, "yyyy-MM-dd'T'HH:mm:ss");
+    }
+    rows.push(row);
+  });
+
+  if (rows.length === 0) { ui.alert("Нет новых строк"); return; }
+
+  const options = {
+    'method': 'post',
+    'contentType': 'application/json',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'payload': JSON.stringify({ rows: rows }),
+    'muteHttpExceptions': true
+  };
+
+  const response = UrlFetchApp.fetch(BASE_URL + "/transactions/batch", options);
+  if (response.getResponseCode() !== 200) {
+    ui.alert("Ошибка: " + response.getContentText());
+    return;
+  }
+
+  const json = JSON.parse(response.getContentText());
+  (json.results || []).forEach(r => {
+    const cell = sheet.getRange(r.row, 4);
+    cell.setValue(r.success ? "Сохранено" : r.message);
+    cell.setFontColor(r.success ? "green" : "red");
+  });
+  ui.alert("Сохранено: " + (json.inserted || 0) + ", уже загружено: " + (json.skipped || 0) + ", ошибок: " + (json.failed || 0));
+}
+
+// Колонки листа при синхронизации: 1 сумма, 2 категория, 3 описание,
+// 4 статус, 5 дата, 6 UUID строки, 7 ID транзакции, 8 версия, 9 хэш.
+// Хэш хранит содержимое строки на момент последней синхронизации, по нему
+// видно, что строку правили в таблице. Известные строки хранятся в
+// свойствах документа, чтобы заметить удаленные из таблицы.
+function syncSheet() {
+  const sheet = SpreadsheetApp.getActiveSpreadsheet().getActiveSheet();
+  const ui = SpreadsheetApp.getUi();
+  const props = PropertiesService.getDocumentProperties();
+
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Сначала войдите в систему!"); return; }
+
+  const tz = SpreadsheetApp.getActive().getSpreadsheetTimeZone();
+  const known = JSON.parse(props.getProperty('SYNC_ROWS') || '{}');
+  const headers = { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' };
+
+  const lastRow = sheet.getLastRow();
+  const values = lastRow < 2 ? [] : sheet.getRange(2, 1, lastRow - 1, 9).getValues();
+  const rows = [];
+  const present = {};
+  values.forEach((v, i) => {
+    if (v[0] === "" || v[1] === "") return;
+    let uuid = v[5].toString();
+    if (!uuid) {
+      uuid = Utilities.getUuid();
+      sheet.getRange(i + 2, 6).setValue(uuid);
+    }
+    present[uuid] = true;
+    if (v[8] !== "" && v[8] === rowHash(v, tz)) return;
+
+    const row = {
+      row: i + 2,
+      row_uuid: uuid,
+      transaction_id: Number(v[6]) || 0,
+      version: Number(v[7]) || 0,
+      amount: parseFloat(v[0]),
+      category: v[1].toString(),
+      description: v[2].toString()
+    };
+    if (v[4] instanceof Date) {
+      row.occurred_at = Utilities.formatDate(v[4], tz, "yyyy-MM-dd'T'HH:mm:ss");
+    }
+    rows.push(row);
+  });
+  Object.keys(known).forEach(uuid => {
+    if (present[uuid]) return;
+    rows.push({ row_uuid: uuid, transaction_id: known[uuid][0], version: known[uuid][1], deleted: true });
+  });
+
+  let conflicts = 0;
+  if (rows.length > 0) {
+    const response = UrlFetchApp.fetch(BASE_URL + "/sync/push", {
+      'method': 'post',
+      'contentType': 'application/json',
+      'headers': headers,
+      'payload': JSON.stringify({ rows: rows }),
+      'muteHttpExceptions': true
+    });
+    if (response.getResponseCode() !== 200) {
+      ui.alert("Ошибка: " + response.getContentText());
+      return;
+    }
+    const results = JSON.parse(response.getContentText()).results || [];
+    results.forEach(r => {
+      if (r.status === "conflict") conflicts++;
+      if (r.row && r.status === "error") {
+        const cell = sheet.getRange(r.row, 4);
+        cell.setValue(r.message);
+        cell.setFontColor("red");
+      }
+    });
+    // При конфликте сервер возвращает свою копию строки, она заменяет правку.
+    results.forEach(r => {
+      if (r.server && r.status !== "error") applyChange(sheet, r.server, known, tz);
+    });
+    props.setProperty('SYNC_ROWS', JSON.stringify(known));
+  }
+
+  // Изменения с сервера, включая только что отправленные, применяются к
+  // таблице так же, как правки из других источников.
+  let since = props.getProperty('SYNC_TOKEN') || "";
+  for (;;) {
+    const response = UrlFetchApp.fetch(BASE_URL + "/sync/changes?since=" + encodeURIComponent(since), {
+      'method': 'get',
+      'headers': headers,
+      'muteHttpExceptions': true
+    });
+    if (response.getResponseCode() !== 200) {
+      ui.alert("Ошибка: " + response.getContentText());
+      break;
+    }
+    const json = JSON.parse(response.getContentText());
+    (json.changes || []).forEach(c => applyChange(sheet, c, known, tz));
+    since = json.change_token || since;
+    props.setProperty('SYNC_TOKEN', since);
+    props.setProperty('SYNC_ROWS', JSON.stringify(known));
+    if (!json.has_more) break;
+  }
+
+  ui.alert("Синхронизировано. Конфликтов: " + conflicts);
+}
+
+function applyChange(sheet, c, known, tz) {
+  const lastRow = sheet.getLastRow();
+  const ids = lastRow < 2 ? [] : sheet.getRange(2, 6, lastRow - 1, 2).getValues();
+  let row = 0;
+  for (let i = 0; i < ids.length; i++) {
+    if ((c.row_uuid && ids[i][0] === c.row_uuid) || Number(ids[i][1]) === c.transaction_id) {
+      row = i + 2;
+      break;
+    }
+  }
+
+  let uuid = c.row_uuid || (row ? ids[row - 2][0].toString() : "");
+  if (c.deleted) {
+    if (row) sheet.deleteRow(row);
+    delete known[uuid];
+    return;
+  }
+  if (!uuid) uuid = Utilities.getUuid();
+
+  if (!row) row = sheet.getLastRow() + 1;
+  const v = [c.amount || 0, c.category, c.description || "", "Синхронизировано", new Date(c.occurred_at), uuid, c.transaction_id, c.version];
+  v.push(rowHash(v, tz));
+  sheet.getRange(row, 1, 1, 9).setValues([v]);
+  sheet.getRange(row, 4).setFontColor("green");
+  known[uuid] = [c.transaction_id, c.version];
+}
+
+function rowHash(v, tz) {
+  const date = v[4] instanceof Date ? Utilities.formatDate(v[4], tz, "yyyy-MM-dd'T'HH:mm:ss") : "";
+  const digest = Utilities.computeDigest(Utilities.DigestAlgorithm.MD5, [v[0], v[1], v[2], date].join("\u0000"));
+  return Utilities.base64Encode(digest);
+}
+
+// Записывает покупку по строке из QR-кода кассового чека. Повторный ввод
+// того же чека не создает второй расход.
+function sendReceipt() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Сначала войдите в систему!"); return; }
+
+  const qr = ui.prompt('Чек', 'Строка из QR-кода (t=...&s=...&fn=...):', ui.ButtonSet.OK).getResponseText();
+  const cat = ui.prompt('Чек', 'Категория (например, Еда):', ui.ButtonSet.OK).getResponseText();
+  if (!qr || !cat) return;
+
+  const options = {
+    'method': 'post',
+    'contentType': 'application/json',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'payload': JSON.stringify({ qr: qr, category: cat }),
+    'muteHttpExceptions': true
+  };
+
+  const response = UrlFetchApp.fetch(BASE_URL + "/transaction/receipt", options);
+  if (response.getResponseCode() !== 200) {
+    ui.alert("Ошибка: " + response.getContentText());
+    return;
+  }
+  const json = JSON.parse(response.getContentText());
+  ui.alert(json.success ? json.message + " (ID " + json.transaction_id + ")" : "Ошибка: " + json.message);
+}
+
+// Записывает трату из одной строки текста, например "кофе 250" или
+// "такси 480 вчера", и добавляет распознанную строку в таблицу.
+function quickAdd() {
+  const sheet = SpreadsheetApp.getActiveSpreadsheet().getActiveSheet();
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Сначала войдите в систему!"); return; }
+
+  const text = ui.prompt('Быстрый ввод', 'Например: кофе 250, такси 480 вчера:', ui.ButtonSet.OK).getResponseText();
+  if (!text) return;
+
+  const options = {
+    'method': 'post',
+    'contentType': 'application/json',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true', 'Idempotency-Key': Utilities.getUuid() },
+    'payload': JSON.stringify({ text: text }),
+    'muteHttpExceptions': true
+  };
+
+  const response = fetchWithRetry(BASE_URL + "/transaction/quick", options, 3);
+  if (response.getResponseCode() !== 200) {
+    ui.alert("Ошибка: " + response.getContentText());
+    return;
+  }
+  const json = JSON.parse(response.getContentText());
+  if (!json.success) { ui.alert("Ошибка: " + json.message); return; }
+
+  sheet.appendRow([json.amount, json.category, json.description || "", "Сохранено", new Date(json.occurred_at), "", json.transaction_id]);
+  sheet.getRange(sheet.getLastRow(), 4).setFontColor("green");
+}
+
+// Повторяет запрос при сетевых ошибках. Повторы безопасны, пока в options
+// передается один и тот же Idempotency-Key.
+function fetchWithRetry(url, options, attempts) {
+  for (let i = 1; ; i++) {
+    try {
+      return UrlFetchApp.fetch(url, options);
+    } catch (e) {
+      if (i >= attempts) throw e;
+      Utilities.sleep(1000 * i);
+    }
+  }
+}
+
 function getReport() {
   const ui = SpreadsheetApp.getUi();
   const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
@@ -190,17 +503,94 @@ function getReport() {
   }
 }
 
+function getPivot() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Сначала войдите в систему!"); return; }
+
+  const options = {
+    'method': 'get',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'muteHttpExceptions': true
+  };
+
+  const response = UrlFetchApp.fetch(BASE_URL + "/report/pivot", options);
+  if (response.getResponseCode() !== 200) {
+    ui.alert("Ошибка: " + response.getContentText());
+    return;
+  }
+
+  const json = JSON.parse(response.getContentText());
+  const periods = json.periods || [];
+  const rows = json.rows || [];
+  const fill = (values) => periods.map((_, i) => (values && values[i]) || 0);
+
+  const table = [["Категория"].concat(periods, ["Итого"])];
+  rows.forEach(r => table.push([r.category].concat(fill(r.values), [r.total || 0])));
+  table.push(["Итого"].concat(fill(json.column_totals), [json.grand_total || 0]));
+
+  const ss = SpreadsheetApp.getActiveSpreadsheet();
+  const sheet = ss.getSheetByName("Сводная") || ss.insertSheet("Сводная");
+  sheet.clearContents();
+  sheet.getRange(1, 1, table.length, table[0].length).setValues(table);
+}
+
+function searchTransactions() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Сначала войдите в систему!"); return; }
+
+  const q = ui.prompt('Поиск', 'Что искать (например, сантехник):', ui.ButtonSet.OK).getResponseText();
+  if (!q) return;
+
+  const options = {
+    'method': 'get',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'muteHttpExceptions': true
+  };
+
+  const response = UrlFetchApp.fetch(BASE_URL + "/search?limit=100&q=" + encodeURIComponent(q), options);
+  if (response.getResponseCode() !== 200) {
+    ui.alert("Ошибка: " + response.getContentText());
+    return;
+  }
+
+  const hits = JSON.parse(response.getContentText()).hits || [];
+  const table = [["Дата", "Сумма", "Категория", "Описание"]];
+  hits.forEach(h => table.push([new Date(h.occurred_at), h.amount, h.category, h.description]));
+
+  const ss = SpreadsheetApp.getActiveSpreadsheet();
+  const sheet = ss.getSheetByName("Поиск") || ss.insertSheet("Поиск");
+  sheet.clearContents();
+  sheet.getRange(1, 1, table.length, table[0].length).setValues(table);
+  if (hits.length === 0) ui.alert("Ничего не найдено");
+}
+
 function setBudget() {
   const ui = SpreadsheetApp.getUi();
   const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
   if (!token) { ui.alert("Войдите!"); return; }
 
-  const cat = ui.prompt('Бюджет', 'Категория (например, Еда):', ui.ButtonSet.OK).getResponseText();
+  const cat = ui.prompt('Бюджет',
+    'Категория (например, Еда)\n* - все расходы\ngroup:Название - группа категорий\ntag:отпуск - расходы с тегом',
+    ui.ButtonSet.OK).getResponseText();
   const limit = ui.prompt('Бюджет', 'Лимит (сумма):', ui.ButtonSet.OK).getResponseText();
   
   if (!cat || !limit) return;
 
   const payload = { category: cat, limit_amount: parseFloat(limit) };
+
+  const from = ui.prompt('Бюджет', 'Действует с (ГГГГ-ММ-ДД, пусто - с этого месяца):', ui.ButtonSet.OK).getResponseText().trim();
+  if (from) payload.effective_from = from;
+
+  const rollover = ui.prompt('Бюджет',
+    'Остаток месяца (пусто - не менять):\nreset - сбрасывать\nunspent - переносить неизрасходованное\noverspent - вычитать перерасход\nboth - переносить и то и другое',
+    ui.ButtonSet.OK).getResponseText().trim();
+  if (rollover) {
+    payload.rollover = rollover;
+    const cap = ui.prompt('Бюджет', 'Максимальная сумма переноса (пусто - без ограничения):', ui.ButtonSet.OK).getResponseText();
+    if (cap) payload.rollover_cap = parseFloat(cap);
+  }
   
   const options = {
     'method': 'post',
@@ -209,8 +599,9 @@ function setBudget() {
     'payload': JSON.stringify(payload)
   };
 
-  UrlFetchApp.fetch(BASE_URL + "/set_budget", options);
-  ui.alert("Бюджет установлен!");
+  const resp = UrlFetchApp.fetch(BASE_URL + "/set_budget", options);
+  const json = JSON.parse(resp.getContentText());
+  ui.alert(json.success ? "Бюджет установлен!" : "Ошибка: " + json.message);
 }
 
 function getBudgets() {
@@ -229,7 +620,11 @@ function getBudgets() {
   let msg = "БЮДЖЕТЫ:\n";
   if (json.budgets) {
     json.budgets.forEach(b => {
-      msg += `${b.category}: ${b.limit_amount} р.\n`;
+      msg += `${b.category}: ${b.effective_limit || 0} р.`;
+      if (b.carryover) {
+        msg += ` (лимит ${b.limit_amount}, перенос ${b.carryover > 0 ? '+' : ''}${b.carryover})`;
+      }
+      msg += `\n`;
     });
   } else {
     msg += "Нет бюджетов";
@@ -237,6 +632,419 @@ function getBudgets() {
   ui.alert(msg);
 }
 
+function saveBudgetTemplate() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const name = ui.prompt('Шаблон бюджетов', 'Название шаблона (текущие бюджеты будут сохранены в него):', ui.ButtonSet.OK).getResponseText();
+  if (!name) return;
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({ name: name, from_current: true })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/budget/templates/save", options).getContentText());
+  ui.alert(json.success ? "Шаблон сохранен!" : "Ошибка: " + json.message);
+}
+
+function applyBudgets() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const template = ui.prompt('Бюджеты', 'Название шаблона (пусто - бюджеты прошлого месяца):', ui.ButtonSet.OK).getResponseText().trim();
+  const period = ui.prompt('Бюджеты', 'Месяц (ГГГГ-ММ, пусто - текущий):', ui.ButtonSet.OK).getResponseText().trim();
+  const percent = ui.prompt('Бюджеты', 'Изменить лимиты на % (например, 10 или -5, пусто - без изменений):', ui.ButtonSet.OK).getResponseText().trim();
+
+  const payload = { template: template, period: period, adjust_percent: parseFloat(percent) || 0 };
+  const send = (preview) => {
+    payload.preview = preview;
+    const options = {
+      'method': 'post',
+      'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+      'contentType': 'application/json',
+      'payload': JSON.stringify(payload)
+    };
+    return JSON.parse(UrlFetchApp.fetch(BASE_URL + "/budget/apply", options).getContentText());
+  };
+
+  const plan = send(true);
+  if (!plan.success) { ui.alert("Ошибка: " + plan.message); return; }
+
+  let msg = `Бюджеты на ${plan.period} (источник: ${plan.source}):\n`;
+  (plan.changes || []).forEach(c => {
+    msg += `${c.category}: ${c.had_limit ? (c.previous_limit || 0) : "нет"} -> ${c.new_limit || 0} р.\n`;
+  });
+  if (ui.alert('Применить?', msg, ui.ButtonSet.YES_NO) !== ui.Button.YES) return;
+
+  const json = send(false);
+  ui.alert(json.success ? "Бюджеты установлены!" : "Ошибка: " + json.message);
+}
+
+// Группу можно использовать в бюджете как "group:Название".
+function setCategoryGroup() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const name = ui.prompt('Группа категорий', 'Название группы:', ui.ButtonSet.OK).getResponseText().trim();
+  if (!name) return;
+  const cats = ui.prompt('Группа категорий', 'Категории через запятую (пусто - удалить группу):', ui.ButtonSet.OK).getResponseText();
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({
+      name: name,
+      categories: cats.split(',').map(c => c.trim()).filter(c => c)
+    })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/categories/groups/set", options).getContentText());
+  ui.alert(json.success ? "Группа сохранена!" : "Ошибка: " + json.message);
+}
+
+function getGoals() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) return;
+
+  const options = {
+    'method': 'get',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' }
+  };
+
+  const resp = UrlFetchApp.fetch(BASE_URL + "/goals", options);
+  const json = JSON.parse(resp.getContentText());
+
+  let msg = "ЦЕЛИ:\n";
+  if (json.goals) {
+    json.goals.forEach(g => {
+      msg += `${g.name}: ${g.saved || 0} из ${g.target_amount} р. (${g.percent || 0}%), до ${g.deadline}\n`;
+      if (!g.completed) {
+        msg += `  нужно ${g.required_monthly || 0} р. в месяц, прогноз: ${g.projected_completion || "нет данных"}\n`;
+      }
+    });
+  } else {
+    msg += "Нет целей";
+  }
+  ui.alert(msg);
+}
+
+function getSubscriptions() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) return;
+
+  const options = {
+    'method': 'get',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' }
+  };
+
+  const resp = UrlFetchApp.fetch(BASE_URL + "/subscriptions?refresh=true", options);
+  const json = JSON.parse(resp.getContentText());
+
+  let msg = "ПОДПИСКИ:\n";
+  if (json.subscriptions) {
+    json.subscriptions.forEach(s => {
+      msg += `${s.name}: ${s.amount} р. (${s.cadence}), следующее списание ${s.next_charge}, в год ${s.yearly_cost} р.\n`;
+      if (s.previous_amount) {
+        msg += `  цена выросла с ${s.previous_amount} р. (${s.price_changed_at})\n`;
+      }
+    });
+    msg += `Итого в год: ${json.total_yearly_cost || 0} р.`;
+  } else {
+    msg += "Подписки не найдены";
+  }
+  ui.alert(msg);
+}
+
+function getDebts() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) return;
+
+  const options = {
+    'method': 'get',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' }
+  };
+
+  const resp = UrlFetchApp.fetch(BASE_URL + "/debts", options);
+  const json = JSON.parse(resp.getContentText());
+
+  let msg = "ДОЛГИ:\n";
+  if (json.debts) {
+    json.debts.forEach(d => {
+      const who = d.direction === 'lent' ? 'мне должен' : 'я должен';
+      msg += `${d.counterparty} (${who}): осталось ${d.outstanding || 0} из ${d.principal} р., ставка ${d.annual_rate || 0}%\n`;
+      if (d.payment) {
+        msg += `  платеж ${d.payment} р. в месяц, проценты уплачены: ${d.interest_paid || 0} р.\n`;
+      }
+    });
+  } else {
+    msg += "Нет долгов";
+  }
+  ui.alert(msg);
+}
+
+function recordIncome() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const amount = ui.prompt('Доход', 'Сумма:', ui.ButtonSet.OK).getResponseText();
+  if (!amount) return;
+  const description = ui.prompt('Доход', 'Описание (например, Зарплата):', ui.ButtonSet.OK).getResponseText();
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({ amount: parseFloat(amount), description: description })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/income", options).getContentText());
+  ui.alert(json.success ? "Доход записан!" : "Ошибка: " + json.message);
+}
+
+function getEnvelopes() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) return;
+
+  const options = {
+    'method': 'get',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'muteHttpExceptions': true
+  };
+
+  const resp = UrlFetchApp.fetch(BASE_URL + "/envelopes", options);
+  if (resp.getResponseCode() !== 200) { ui.alert("Ошибка: " + resp.getContentText()); return; }
+  const json = JSON.parse(resp.getContentText());
+
+  let msg = `Не распределено: ${json.unassigned || 0} р. (доход ${json.income || 0} р.)\n\nКОНВЕРТЫ:\n`;
+  if (json.envelopes) {
+    json.envelopes.forEach(e => {
+      msg += `${e.category}: осталось ${e.balance || 0} р. (выделено ${e.allocated || 0}, потрачено ${e.spent || 0})\n`;
+    });
+  } else {
+    msg += "Нет конвертов";
+  }
+  ui.alert(msg);
+}
+
+function moveEnvelope() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const from = ui.prompt('Конверты', 'Откуда (категория, пусто - из нераспределенного):', ui.ButtonSet.OK).getResponseText();
+  const to = ui.prompt('Конверты', 'Куда (категория, пусто - вернуть в нераспределенное):', ui.ButtonSet.OK).getResponseText();
+  const amount = ui.prompt('Конверты', 'Сумма:', ui.ButtonSet.OK).getResponseText();
+  if (!amount) return;
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({ from_category: from, to_category: to, amount: parseFloat(amount) })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/envelopes/move", options).getContentText());
+  ui.alert(json.success ? "Перемещено!" : "Ошибка: " + json.message);
+}
+
+function setBudgetMode() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const answer = ui.alert('Режим бюджета',
+    'Включить конверты? Доходы будут попадать в нераспределенное, а траты списываться с конвертов.\n"Нет" - вернуться к месячным лимитам.',
+    ui.ButtonSet.YES_NO_CANCEL);
+  if (answer === ui.Button.CANCEL || answer === ui.Button.CLOSE) return;
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({ budget_mode: answer === ui.Button.YES ? 'envelopes' : 'limits' })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/settings/budget_mode", options).getContentText());
+  ui.alert(json.success ? "Режим бюджета изменен!" : "Ошибка: " + json.message);
+}
+
+// ID транзакций видны в колонке 7 после синхронизации.
+function recordRefund() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const id = ui.prompt('Возврат', 'ID покупки:', ui.ButtonSet.OK).getResponseText();
+  if (!id) return;
+  const amount = ui.prompt('Возврат', 'Сумма возврата (пусто - вся сумма покупки):', ui.ButtonSet.OK).getResponseText();
+
+  const payload = { transaction_id: parseInt(id, 10) };
+  if (amount) payload.amount = parseFloat(amount);
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify(payload)
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/transaction/refund", options).getContentText());
+  ui.alert(json.success ? "Возврат записан!" : "Ошибка: " + json.message);
+}
+
+function setRefundAttribution() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const answer = ui.alert('Учет возвратов',
+    'Уменьшать траты в месяце покупки?\n"Нет" - в месяце, когда вернулись деньги.',
+    ui.ButtonSet.YES_NO_CANCEL);
+  if (answer === ui.Button.CANCEL || answer === ui.Button.CLOSE) return;
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({ refund_attribution: answer === ui.Button.YES ? 'purchase' : 'refund' })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/settings/refund_attribution", options).getContentText());
+  ui.alert(json.success ? "Настройка сохранена!" : "Ошибка: " + json.message);
+}
+
+function getExpenseReports() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) return;
+
+  const options = {
+    'method': 'get',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' }
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/expense_reports", options).getContentText());
+  const statuses = { draft: 'черновик', submitted: 'отправлен', approved: 'одобрен', paid: 'оплачен' };
+
+  let msg = "ОТЧЕТЫ О РАСХОДАХ:\n";
+  if (json.reports) {
+    json.reports.forEach(r => {
+      msg += `#${r.report_id} ${r.title} (${statuses[r.status] || r.status}): ${r.total || 0} р., трат: ${(r.items || []).length}\n`;
+      if (r.status === 'paid') {
+        msg += `  возмещено ${r.reimbursed_amount || 0} р.\n`;
+      }
+    });
+  } else {
+    msg += "Нет отчетов";
+  }
+  ui.alert(msg);
+}
+
+// ID транзакций видны в колонке 7 после синхронизации.
+function createExpenseReport() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const title = ui.prompt('Отчет о расходах', 'Название (например, Командировка в Казань):', ui.ButtonSet.OK).getResponseText().trim();
+  if (!title) return;
+  const ids = ui.prompt('Отчет о расходах', 'ID трат через запятую (пусто - все возмещаемые траты):', ui.ButtonSet.OK).getResponseText();
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({
+      title: title,
+      transaction_ids: ids.split(',').map(id => parseInt(id.trim(), 10)).filter(id => id > 0)
+    })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/expense_reports/create", options).getContentText());
+  if (!json.success) { ui.alert("Ошибка: " + json.message); return; }
+  ui.alert(`Отчет #${json.report.report_id} создан на ${json.report.total} р.`);
+}
+
+function setExpenseReportStatus() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const id = ui.prompt('Статус отчета', 'Номер отчета:', ui.ButtonSet.OK).getResponseText();
+  if (!id) return;
+  const status = ui.prompt('Статус отчета',
+    'Новый статус:\nsubmitted - отправлен\napproved - одобрен\ndraft - вернуть в черновик',
+    ui.ButtonSet.OK).getResponseText().trim();
+  if (!status) return;
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({ report_id: parseInt(id, 10), status: status })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/expense_reports/status", options).getContentText());
+  ui.alert(json.success ? "Статус изменен!" : "Ошибка: " + json.message);
+}
+
+function removeExpenseReportItem() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const id = ui.prompt('Отчет о расходах', 'Номер отчета-черновика:', ui.ButtonSet.OK).getResponseText();
+  if (!id) return;
+  const txId = ui.prompt('Отчет о расходах', 'ID траты:', ui.ButtonSet.OK).getResponseText();
+  if (!txId) return;
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify({ report_id: parseInt(id, 10), transaction_id: parseInt(txId, 10) })
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/expense_reports/remove_item", options).getContentText());
+  if (!json.success) { ui.alert("Ошибка: " + json.message); return; }
+  ui.alert(`Трата убрана, в отчете #${json.report.report_id} осталось ${json.report.total || 0} р.`);
+}
+
+function reimburseExpenseReport() {
+  const ui = SpreadsheetApp.getUi();
+  const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');
+  if (!token) { ui.alert("Войдите!"); return; }
+
+  const id = ui.prompt('Возмещение', 'Номер одобренного отчета:', ui.ButtonSet.OK).getResponseText();
+  if (!id) return;
+  const amount = ui.prompt('Возмещение', 'Полученная сумма (пусто - вся сумма отчета):', ui.ButtonSet.OK).getResponseText();
+
+  const payload = { report_id: parseInt(id, 10) };
+  if (amount) payload.amount = parseFloat(amount);
+
+  const options = {
+    'method': 'post',
+    'headers': { 'Authorization': token, 'ngrok-skip-browser-warning': 'true' },
+    'contentType': 'application/json',
+    'payload': JSON.stringify(payload)
+  };
+
+  const json = JSON.parse(UrlFetchApp.fetch(BASE_URL + "/expense_reports/reimburse", options).getContentText());
+  ui.alert(json.success ? "Возмещение записано!" : "Ошибка: " + json.message);
+}
+
 function logoutUser() {
   const ui = SpreadsheetApp.getUi();
   const token = PropertiesService.getScriptProperties().getProperty('JWT_TOKEN');